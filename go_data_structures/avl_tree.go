@@ -0,0 +1,233 @@
+package go_data_structures
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// AVLTree is a self-balancing binary search tree. It shares BinaryTreeNode and
+// the traversal helpers with BinarySearchTree but keeps every subtree's
+// height difference at most one, so lookups stay O(log n).
+type AVLTree[T constraints.Ordered, V any] struct {
+	root *BinaryTreeNode[T, V]
+	size int
+}
+
+var _ BinaryTreeInterface[int, any] = (*AVLTree[int, any])(nil)
+
+// NewAVLTree creates a new instance of an AVL tree.
+func NewAVLTree[T constraints.Ordered, V any]() *AVLTree[T, V] {
+	return &AVLTree[T, V]{}
+}
+
+// Root returns the root node of the tree.
+func (t *AVLTree[T, V]) Root() *BinaryTreeNode[T, V] {
+	return t.root
+}
+
+// Insert inserts a new key-value pair into the tree.
+// Duplicate keys are ignored; the existing value is kept.
+func (t *AVLTree[T, V]) Insert(key T, val V) {
+	if t.Contains(key) {
+		return
+	}
+	t.root = avlInsert(t.root, Pair[T, V]{Key: key, Value: val})
+	t.size++
+}
+
+// Contains checks if a key exists in the tree.
+func (t *AVLTree[T, V]) Contains(key T) bool {
+	return findNode(t.root, key) != nil
+}
+
+// Get returns the value stored under key and whether the key was found.
+func (t *AVLTree[T, V]) Get(key T) (V, bool) {
+	node := findNode(t.root, key)
+	if node == nil {
+		var zero V
+		return zero, false
+	}
+	return node.Data.Value, true
+}
+
+// Remove deletes the node with the given key, if present.
+func (t *AVLTree[T, V]) Remove(key T) {
+	if !t.Contains(key) {
+		return
+	}
+	t.root = avlRemove(t.root, key)
+	t.size--
+}
+
+// Empty returns whether the tree has no nodes.
+func (t *AVLTree[T, V]) Empty() bool {
+	return t.root == nil
+}
+
+// Size returns the number of nodes in the tree.
+func (t *AVLTree[T, V]) Size() int {
+	return t.size
+}
+
+// InOrderTraversal returns an in-order traversal of the tree as a slice of Pair[T, V].
+func (t *AVLTree[T, V]) InOrderTraversal() []Pair[T, V] {
+	var result []Pair[T, V]
+	traverse(t.root, "inorder", &result)
+	return result
+}
+
+// PreOrderTraversal returns a pre-order traversal of the tree as a slice of Pair[T, V].
+func (t *AVLTree[T, V]) PreOrderTraversal() []Pair[T, V] {
+	var result []Pair[T, V]
+	traverse(t.root, "preorder", &result)
+	return result
+}
+
+// PostOrderTraversal returns a post-order traversal of the tree as a slice of Pair[T, V].
+func (t *AVLTree[T, V]) PostOrderTraversal() []Pair[T, V] {
+	var result []Pair[T, V]
+	traverse(t.root, "postorder", &result)
+	return result
+}
+
+// LevelOrderTraversal returns a level-order traversal of the tree as a slice of Pair[T, V].
+func (t *AVLTree[T, V]) LevelOrderTraversal() []Pair[T, V] {
+	var result []Pair[T, V]
+	if t.root == nil {
+		return result
+	}
+	queue := []*BinaryTreeNode[T, V]{t.root}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		result = append(result, current.Data)
+		if current.Left != nil {
+			queue = append(queue, current.Left)
+		}
+		if current.Right != nil {
+			queue = append(queue, current.Right)
+		}
+	}
+	return result
+}
+
+func nodeHeight[T constraints.Ordered, V any](node *BinaryTreeNode[T, V]) int {
+	if node == nil {
+		return 0
+	}
+	return node.height
+}
+
+func updateHeight[T constraints.Ordered, V any](node *BinaryTreeNode[T, V]) {
+	node.height = 1 + max(nodeHeight(node.Left), nodeHeight(node.Right))
+}
+
+func rotateRight[T constraints.Ordered, V any](node *BinaryTreeNode[T, V]) *BinaryTreeNode[T, V] {
+	left := node.Left
+	node.Left = left.Right
+	left.Right = node
+	updateHeight(node)
+	updateHeight(left)
+	return left
+}
+
+func rotateLeft[T constraints.Ordered, V any](node *BinaryTreeNode[T, V]) *BinaryTreeNode[T, V] {
+	right := node.Right
+	node.Right = right.Left
+	right.Left = node
+	updateHeight(node)
+	updateHeight(right)
+	return right
+}
+
+// rebalance restores the AVL invariant at node after one of its subtrees changed height.
+func rebalance[T constraints.Ordered, V any](node *BinaryTreeNode[T, V]) *BinaryTreeNode[T, V] {
+	updateHeight(node)
+	balance := nodeHeight(node.Left) - nodeHeight(node.Right)
+	if balance > 1 {
+		if nodeHeight(node.Left.Left) < nodeHeight(node.Left.Right) {
+			node.Left = rotateLeft(node.Left)
+		}
+		return rotateRight(node)
+	}
+	if balance < -1 {
+		if nodeHeight(node.Right.Right) < nodeHeight(node.Right.Left) {
+			node.Right = rotateRight(node.Right)
+		}
+		return rotateLeft(node)
+	}
+	return node
+}
+
+func avlInsert[T constraints.Ordered, V any](node *BinaryTreeNode[T, V], data Pair[T, V]) *BinaryTreeNode[T, V] {
+	if node == nil {
+		return &BinaryTreeNode[T, V]{Data: data, height: 1}
+	}
+	if data.Key < node.Data.Key {
+		node.Left = avlInsert(node.Left, data)
+	} else if data.Key > node.Data.Key {
+		node.Right = avlInsert(node.Right, data)
+	} else {
+		return node
+	}
+	return rebalance(node)
+}
+
+func avlRemove[T constraints.Ordered, V any](node *BinaryTreeNode[T, V], key T) *BinaryTreeNode[T, V] {
+	if node == nil {
+		return nil
+	}
+	if key < node.Data.Key {
+		node.Left = avlRemove(node.Left, key)
+	} else if key > node.Data.Key {
+		node.Right = avlRemove(node.Right, key)
+	} else {
+		if node.Left == nil {
+			return node.Right
+		} else if node.Right == nil {
+			return node.Left
+		}
+		// Node with two children: replace it with its in-order successor.
+		minNode := findMin(node.Right)
+		node.Data = minNode.Data
+		node.Right = avlRemove(node.Right, minNode.Data.Key)
+	}
+	return rebalance(node)
+}
+
+// ceilingNode returns the node with the smallest key >= key, or > key when
+// inclusive is false. It works on any binary search tree built from BinaryTreeNode.
+func ceilingNode[T constraints.Ordered, V any](node *BinaryTreeNode[T, V], key T, inclusive bool) *BinaryTreeNode[T, V] {
+	var best *BinaryTreeNode[T, V]
+	for node != nil {
+		if node.Data.Key > key || (inclusive && node.Data.Key == key) {
+			best = node
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+	return best
+}
+
+// floorNode returns the node with the largest key <= key, or < key when
+// inclusive is false.
+func floorNode[T constraints.Ordered, V any](node *BinaryTreeNode[T, V], key T, inclusive bool) *BinaryTreeNode[T, V] {
+	var best *BinaryTreeNode[T, V]
+	for node != nil {
+		if node.Data.Key < key || (inclusive && node.Data.Key == key) {
+			best = node
+			node = node.Right
+		} else {
+			node = node.Left
+		}
+	}
+	return best
+}
+
+func findMax[T constraints.Ordered, V any](node *BinaryTreeNode[T, V]) *BinaryTreeNode[T, V] {
+	current := node
+	for current.Right != nil {
+		current = current.Right
+	}
+	return current
+}
@@ -0,0 +1,78 @@
+package go_data_structures
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// checkAVL verifies BST order, stored heights and the AVL balance condition
+// under node, returning the subtree's height and size.
+func checkAVL(t *testing.T, node *BinaryTreeNode[int, int], lo, hi *int) (int, int) {
+	t.Helper()
+	if node == nil {
+		return 0, 0
+	}
+	key := node.Data.Key
+	if (lo != nil && key <= *lo) || (hi != nil && key >= *hi) {
+		t.Fatalf("key %d out of order", key)
+	}
+	lh, ls := checkAVL(t, node.Left, lo, &key)
+	rh, rs := checkAVL(t, node.Right, &key, hi)
+	if lh-rh > 1 || rh-lh > 1 {
+		t.Fatalf("node %d unbalanced: left height %d, right height %d", key, lh, rh)
+	}
+	if h := 1 + max(lh, rh); node.height != h {
+		t.Fatalf("node %d stores height %d, want %d", key, node.height, h)
+	}
+	return 1 + max(lh, rh), 1 + ls + rs
+}
+
+func TestAVLTreeMatchesMap(t *testing.T) {
+	r := rand.New(rand.NewSource(13))
+	tree := NewAVLTree[int, int]()
+	model := make(map[int]int)
+	for op := 0; op < 5000; op++ {
+		key := r.Intn(300)
+		if r.Intn(3) == 0 {
+			tree.Remove(key)
+			delete(model, key)
+		} else {
+			if _, ok := model[key]; !ok {
+				model[key] = op // Insert keeps the first value for a key
+			}
+			tree.Insert(key, op)
+		}
+		_, size := checkAVL(t, tree.Root(), nil, nil)
+		if size != len(model) || tree.Size() != len(model) {
+			t.Fatalf("tree holds %d nodes, Size() = %d, want %d", size, tree.Size(), len(model))
+		}
+		probe := r.Intn(300)
+		v, ok := tree.Get(probe)
+		if want, has := model[probe]; ok != has || v != want || tree.Contains(probe) != has {
+			t.Fatalf("Get(%d) = %d, %v, want %d, %v", probe, v, ok, want, has)
+		}
+	}
+	keys := make([]int, 0, len(model))
+	for k := range model {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	for i, pair := range tree.InOrderTraversal() {
+		if pair.Key != keys[i] || pair.Value != model[pair.Key] {
+			t.Fatalf("InOrderTraversal()[%d] = %v, want key %d", i, pair, keys[i])
+		}
+	}
+}
+
+func TestAVLTreeHeightIsLogarithmic(t *testing.T) {
+	// Sorted insertion degenerates a plain BST into a list.
+	tree := NewAVLTree[int, struct{}]()
+	for i := 0; i < 1<<12; i++ {
+		tree.Insert(i, struct{}{})
+	}
+	// An AVL tree of n nodes is at most about 1.44 log2(n) high.
+	if h := nodeHeight(tree.Root()); h > 18 {
+		t.Fatalf("height %d after %d sorted inserts", h, 1<<12)
+	}
+}
@@ -12,6 +12,7 @@ type BinaryTreeNode[T constraints.Ordered, V any] struct {
     Data  Pair[T, V]       // Corrected to store a Pair[T, V] directly
     Left  *BinaryTreeNode[T, V]
     Right *BinaryTreeNode[T, V]
+    height int // Subtree height, only maintained by AVLTree
 }
 
 // BinaryTreeInterface defines the operations for a binary tree.
@@ -38,13 +39,22 @@ func NewBinarySearchTree[T constraints.Ordered, V any]() *BinarySearchTree[T, V]
 	return &BinarySearchTree[T, V]{}
 }
 
+// Root returns the root node of the binary search tree.
+func (bst *BinarySearchTree[T, V]) Root() *BinaryTreeNode[T, V] {
+	return bst.root
+}
+
 // Insert inserts a new key-value pair into the binary search tree.
+// Duplicate keys are ignored; the existing value is kept.
 func (bst *BinarySearchTree[T, V]) Insert(key T, val V) {
     if bst.root == nil {
         bst.root = &BinaryTreeNode[T, V]{Data: Pair[T, V]{Key: key, Value: val}}
         bst.size++
         return
     }
+    if bst.Contains(key) {
+        return
+    }
     bst.root = insertNode(bst.root, Pair[T, V]{Key: key, Value: val})
     bst.size++
 }
@@ -69,6 +79,27 @@ func (bst *BinarySearchTree[T, V]) Contains(key T) bool {
 	return containsNode(bst.root, key)
 }
 
+// Get returns the value stored under key and whether the key was found.
+func (bst *BinarySearchTree[T, V]) Get(key T) (V, bool) {
+	node := findNode(bst.root, key)
+	if node == nil {
+		var zero V
+		return zero, false
+	}
+	return node.Data.Value, true
+}
+
+func findNode[T constraints.Ordered, V any](node *BinaryTreeNode[T, V], key T) *BinaryTreeNode[T, V] {
+	for node != nil && key != node.Data.Key {
+		if key < node.Data.Key {
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+	return node
+}
+
 func containsNode[T constraints.Ordered, V any](node *BinaryTreeNode[T, V], key T) bool {
     if node == nil {
         return false
@@ -82,6 +113,24 @@ func containsNode[T constraints.Ordered, V any](node *BinaryTreeNode[T, V], key
     }
 }
 
+// Remove deletes the node with the given key, if present.
+func (bst *BinarySearchTree[T, V]) Remove(key T) {
+	if !bst.Contains(key) {
+		return
+	}
+	bst.root = removeNode(bst.root, key)
+	bst.size--
+}
+
+// Empty returns whether the binary search tree has no nodes.
+func (bst *BinarySearchTree[T, V]) Empty() bool {
+	return bst.root == nil
+}
+
+// Size returns the number of nodes in the binary search tree.
+func (bst *BinarySearchTree[T, V]) Size() int {
+	return bst.size
+}
 
 func removeNode[T constraints.Ordered, V any](node *BinaryTreeNode[T, V], key T) *BinaryTreeNode[T, V] {
     if node == nil {
@@ -0,0 +1,21 @@
+package go_data_structures
+
+import "testing"
+
+func TestBinarySearchTreeIgnoresDuplicateKeys(t *testing.T) {
+	bst := NewBinarySearchTree[int, string]()
+	bst.Insert(2, "two")
+	bst.Insert(1, "one")
+	bst.Insert(2, "again")
+	if bst.Size() != 2 {
+		t.Fatalf("Size() = %d after inserting a duplicate key, want 2", bst.Size())
+	}
+	if v, ok := bst.Get(2); !ok || v != "two" {
+		t.Fatalf("Get(2) = %q, %v, want the first value", v, ok)
+	}
+	bst.Remove(2)
+	bst.Remove(5)
+	if bst.Contains(2) || bst.Size() != 1 {
+		t.Fatalf("Remove left Size() = %d", bst.Size())
+	}
+}
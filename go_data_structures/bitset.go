@@ -0,0 +1,249 @@
+package go_data_structures
+
+import (
+	"math/bits"
+)
+
+const wordSize = 64
+
+// Bitset is a dense set of non-negative integers stored as one bit per value
+// in a slice of uint64 words. It grows as needed to hold the largest value.
+type Bitset struct {
+	words []uint64
+	count int // number of set bits, kept in sync by every mutation
+}
+
+var _ SetInterface[uint] = (*Bitset)(nil)
+
+// NewBitset creates a Bitset with room for values below capacity without growing.
+func NewBitset(capacity uint) *Bitset {
+	return &Bitset{words: make([]uint64, (capacity+wordSize-1)/wordSize)}
+}
+
+// grow makes sure the word holding bit i exists.
+func (b *Bitset) grow(i uint) {
+	need := int(i/wordSize) + 1
+	if need <= len(b.words) {
+		return
+	}
+	if need <= cap(b.words) {
+		b.words = b.words[:need]
+		return
+	}
+	words := make([]uint64, need, max(need, 2*cap(b.words)))
+	copy(words, b.words)
+	b.words = words
+}
+
+// Set sets bit i. O(1) amortized
+func (b *Bitset) Set(i uint) {
+	b.grow(i)
+	w, mask := i/wordSize, uint64(1)<<(i%wordSize)
+	if b.words[w]&mask == 0 {
+		b.words[w] |= mask
+		b.count++
+	}
+}
+
+// Clear clears bit i. O(1)
+func (b *Bitset) Clear(i uint) {
+	w, mask := i/wordSize, uint64(1)<<(i%wordSize)
+	if w < uint(len(b.words)) && b.words[w]&mask != 0 {
+		b.words[w] &^= mask
+		b.count--
+	}
+}
+
+// Test returns whether bit i is set. O(1)
+func (b *Bitset) Test(i uint) bool {
+	w := i / wordSize
+	return w < uint(len(b.words)) && b.words[w]&(1<<(i%wordSize)) != 0
+}
+
+// Flip toggles bit i. O(1) amortized
+func (b *Bitset) Flip(i uint) {
+	if b.Test(i) {
+		b.Clear(i)
+	} else {
+		b.Set(i)
+	}
+}
+
+// Count returns the number of set bits. O(1)
+func (b *Bitset) Count() int {
+	return b.count
+}
+
+// NextSet returns the first set bit at or after i, and false if there is none.
+func (b *Bitset) NextSet(i uint) (uint, bool) {
+	w := i / wordSize
+	if w >= uint(len(b.words)) {
+		return 0, false
+	}
+	// Mask off the bits below i in the first word.
+	word := b.words[w] >> (i % wordSize) << (i % wordSize)
+	for {
+		if word != 0 {
+			return w*wordSize + uint(bits.TrailingZeros64(word)), true
+		}
+		w++
+		if w >= uint(len(b.words)) {
+			return 0, false
+		}
+		word = b.words[w]
+	}
+}
+
+// NextClear returns the first clear bit at or after i. Bits past the end of
+// the underlying words are clear, so there is always an answer.
+func (b *Bitset) NextClear(i uint) uint {
+	w := i / wordSize
+	if w >= uint(len(b.words)) {
+		return i
+	}
+	// Treat the bits below i in the first word as set so they are skipped.
+	word := b.words[w] | (uint64(1)<<(i%wordSize) - 1)
+	for {
+		if word != ^uint64(0) {
+			return w*wordSize + uint(bits.TrailingZeros64(^word))
+		}
+		w++
+		if w >= uint(len(b.words)) {
+			return w * wordSize
+		}
+		word = b.words[w]
+	}
+}
+
+// Each calls fn for every set bit in ascending order until fn returns false.
+func (b *Bitset) Each(fn func(i uint) bool) {
+	for w, word := range b.words {
+		for word != 0 {
+			t := bits.TrailingZeros64(word)
+			if !fn(uint(w*wordSize + t)) {
+				return
+			}
+			word &= word - 1
+		}
+	}
+}
+
+// Clone returns a copy of the bitset.
+func (b *Bitset) Clone() *Bitset {
+	return &Bitset{words: append([]uint64(nil), b.words...), count: b.count}
+}
+
+// recount recomputes the cached population count after a bulk operation.
+func (b *Bitset) recount() {
+	b.count = 0
+	for _, word := range b.words {
+		b.count += bits.OnesCount64(word)
+	}
+}
+
+// InPlaceAnd keeps only the bits set in both b and other.
+func (b *Bitset) InPlaceAnd(other *Bitset) {
+	for i := range b.words {
+		if i < len(other.words) {
+			b.words[i] &= other.words[i]
+		} else {
+			b.words[i] = 0
+		}
+	}
+	b.recount()
+}
+
+// InPlaceOr adds the bits set in other.
+func (b *Bitset) InPlaceOr(other *Bitset) {
+	if len(other.words) > 0 {
+		b.grow(uint(len(other.words)*wordSize - 1))
+	}
+	for i, word := range other.words {
+		b.words[i] |= word
+	}
+	b.recount()
+}
+
+// InPlaceXor toggles the bits set in other.
+func (b *Bitset) InPlaceXor(other *Bitset) {
+	if len(other.words) > 0 {
+		b.grow(uint(len(other.words)*wordSize - 1))
+	}
+	for i, word := range other.words {
+		b.words[i] ^= word
+	}
+	b.recount()
+}
+
+// InPlaceAndNot clears the bits set in other.
+func (b *Bitset) InPlaceAndNot(other *Bitset) {
+	for i := range b.words {
+		if i < len(other.words) {
+			b.words[i] &^= other.words[i]
+		}
+	}
+	b.recount()
+}
+
+// And returns a new bitset with the bits set in both b and other.
+func (b *Bitset) And(other *Bitset) *Bitset {
+	result := b.Clone()
+	result.InPlaceAnd(other)
+	return result
+}
+
+// Or returns a new bitset with the bits set in either b or other.
+func (b *Bitset) Or(other *Bitset) *Bitset {
+	result := b.Clone()
+	result.InPlaceOr(other)
+	return result
+}
+
+// Xor returns a new bitset with the bits set in exactly one of b and other.
+func (b *Bitset) Xor(other *Bitset) *Bitset {
+	result := b.Clone()
+	result.InPlaceXor(other)
+	return result
+}
+
+// AndNot returns a new bitset with the bits set in b but not in other.
+func (b *Bitset) AndNot(other *Bitset) *Bitset {
+	result := b.Clone()
+	result.InPlaceAndNot(other)
+	return result
+}
+
+// Contains returns whether val is in the set. O(1)
+func (b *Bitset) Contains(val uint) bool {
+	return b.Test(val)
+}
+
+// Insert adds val to the set. O(1) amortized
+func (b *Bitset) Insert(val uint) {
+	b.Set(val)
+}
+
+// Remove deletes val from the set. O(1)
+func (b *Bitset) Remove(val uint) {
+	b.Clear(val)
+}
+
+// Empty returns whether no bits are set. O(1)
+func (b *Bitset) Empty() bool {
+	return b.count == 0
+}
+
+// Size returns the number of set bits. O(1)
+func (b *Bitset) Size() int {
+	return b.count
+}
+
+// Values returns the set bits in ascending order.
+func (b *Bitset) Values() []uint {
+	values := make([]uint, 0, b.count)
+	b.Each(func(i uint) bool {
+		values = append(values, i)
+		return true
+	})
+	return values
+}
@@ -0,0 +1,171 @@
+package go_data_structures
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// bitsetModel builds a Bitset and a map holding the same random values
+// below limit.
+func bitsetModel(r *rand.Rand, limit, n int) (*Bitset, map[uint]bool) {
+	b, m := NewBitset(0), make(map[uint]bool)
+	for i := 0; i < n; i++ {
+		v := uint(r.Intn(limit))
+		b.Set(v)
+		m[v] = true
+	}
+	return b, m
+}
+
+// checkBitset compares b, including its cached count, against the model.
+func checkBitset(t *testing.T, name string, b *Bitset, m map[uint]bool) {
+	t.Helper()
+	want := make([]uint, 0, len(m))
+	for v, ok := range m {
+		if ok {
+			want = append(want, v)
+		}
+	}
+	sort.Slice(want, func(i, j int) bool { return want[i] < want[j] })
+	if got := b.Values(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("%s: Values() = %v, want %v", name, got, want)
+	}
+	if b.Count() != len(want) || b.Size() != len(want) || b.Empty() != (len(want) == 0) {
+		t.Fatalf("%s: Count() = %d, want %d", name, b.Count(), len(want))
+	}
+}
+
+func TestBitsetWordBoundaries(t *testing.T) {
+	b := NewBitset(0)
+	for _, i := range []uint{63, 64, 65} {
+		b.Set(i)
+	}
+	for i := uint(60); i < 70; i++ {
+		if want := i >= 63 && i <= 65; b.Test(i) != want {
+			t.Fatalf("Test(%d) = %v, want %v", i, b.Test(i), want)
+		}
+	}
+	if v, ok := b.NextSet(0); !ok || v != 63 {
+		t.Fatalf("NextSet(0) = %d, %v, want 63", v, ok)
+	}
+	if v, ok := b.NextSet(64); !ok || v != 64 {
+		t.Fatalf("NextSet(64) = %d, %v, want 64", v, ok)
+	}
+	if _, ok := b.NextSet(66); ok {
+		t.Fatal("NextSet(66) found a bit")
+	}
+	if v := b.NextClear(63); v != 66 {
+		t.Fatalf("NextClear(63) = %d, want 66", v)
+	}
+	b.Clear(64)
+	if v := b.NextClear(63); v != 64 {
+		t.Fatalf("NextClear(63) after Clear(64) = %d, want 64", v)
+	}
+	b.Flip(64)
+	b.Flip(63)
+	if got := b.Values(); !reflect.DeepEqual(got, []uint{64, 65}) || b.Count() != 2 {
+		t.Fatalf("after flips Values() = %v, Count() = %d", got, b.Count())
+	}
+
+	// A full word: the next clear bit is the first bit of the next word.
+	full := NewBitset(0)
+	for i := uint(0); i < 64; i++ {
+		full.Set(i)
+	}
+	if v := full.NextClear(0); v != 64 {
+		t.Fatalf("NextClear(0) on a full word = %d, want 64", v)
+	}
+}
+
+func TestBitsetBulkOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(16))
+	// Operands of different lengths, in both orders.
+	limits := [][2]int{{64, 64}, {65, 300}, {300, 65}, {1000, 63}, {63, 1000}}
+	for _, lim := range limits {
+		for iter := 0; iter < 20; iter++ {
+			a, ma := bitsetModel(r, lim[0], r.Intn(lim[0]))
+			b, mb := bitsetModel(r, lim[1], r.Intn(lim[1]))
+			and, or, xor, andNot := map[uint]bool{}, map[uint]bool{}, map[uint]bool{}, map[uint]bool{}
+			for v := range ma {
+				or[v] = true
+				if mb[v] {
+					and[v] = true
+				} else {
+					xor[v], andNot[v] = true, true
+				}
+			}
+			for v := range mb {
+				or[v] = true
+				if !ma[v] {
+					xor[v] = true
+				}
+			}
+			checkBitset(t, "And", a.And(b), and)
+			checkBitset(t, "Or", a.Or(b), or)
+			checkBitset(t, "Xor", a.Xor(b), xor)
+			checkBitset(t, "AndNot", a.AndNot(b), andNot)
+			// The copying forms must leave their operands alone.
+			checkBitset(t, "a", a, ma)
+			checkBitset(t, "b", b, mb)
+
+			ops := []struct {
+				name string
+				fn   func(*Bitset, *Bitset)
+				want map[uint]bool
+			}{
+				{"InPlaceAnd", (*Bitset).InPlaceAnd, and},
+				{"InPlaceOr", (*Bitset).InPlaceOr, or},
+				{"InPlaceXor", (*Bitset).InPlaceXor, xor},
+				{"InPlaceAndNot", (*Bitset).InPlaceAndNot, andNot},
+			}
+			for _, op := range ops {
+				c := a.Clone()
+				op.fn(c, b)
+				checkBitset(t, op.name, c, op.want)
+				// The cached count must stay right for later single-bit updates.
+				c.Set(uint(lim[0] + lim[1]))
+				c.Clear(0)
+				want := make(map[uint]bool, len(op.want)+1)
+				for v := range op.want {
+					want[v] = true
+				}
+				want[uint(lim[0]+lim[1])] = true
+				delete(want, 0)
+				checkBitset(t, op.name+" then Set/Clear", c, want)
+			}
+		}
+	}
+}
+
+func TestBitsetNextMatchesMap(t *testing.T) {
+	r := rand.New(rand.NewSource(17))
+	for iter := 0; iter < 20; iter++ {
+		limit := 1 + r.Intn(400)
+		b, m := bitsetModel(r, limit, r.Intn(2*limit))
+		for i := 0; i < limit/3; i++ {
+			v := uint(r.Intn(limit))
+			b.Clear(v)
+			delete(m, v)
+		}
+		checkBitset(t, "bitset", b, m)
+		for i := uint(0); i < uint(limit)+wordSize+2; i++ {
+			next := i
+			for next < uint(limit) && !m[next] {
+				next++
+			}
+			got, ok := b.NextSet(i)
+			if want := next < uint(limit); ok != want || (ok && got != next) {
+				t.Fatalf("NextSet(%d) = %d, %v, want %d, %v", i, got, ok, next, want)
+			}
+			clear := i
+			for m[clear] {
+				clear++
+			}
+			if got := b.NextClear(i); got != clear {
+				t.Fatalf("NextClear(%d) = %d, want %d", i, got, clear)
+			}
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package go_data_structures
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrQueueClosed is returned when offering to a closed queue, or taking from
+// one that is closed and drained.
+var ErrQueueClosed = errors.New("queue closed")
+
+// waitSignal wakes every goroutine waiting on it at the next broadcast. Its
+// channel is made only when someone waits. The owner's mutex guards it.
+type waitSignal struct {
+	ch chan struct{}
+}
+
+// channel returns a channel that is closed at the next broadcast.
+func (s *waitSignal) channel() <-chan struct{} {
+	if s.ch == nil {
+		s.ch = make(chan struct{})
+	}
+	return s.ch
+}
+
+// broadcast wakes every current waiter.
+func (s *waitSignal) broadcast() {
+	if s.ch != nil {
+		close(s.ch)
+		s.ch = nil
+	}
+}
+
+// blockingContainer is the store behind a blockingQueue, which decides the
+// order items come out in. pop is only called when size is positive.
+type blockingContainer[T any] interface {
+	push(val T)
+	pop() T
+	size() int
+}
+
+// blockingQueue is the locking, capacity and wake-up logic shared by
+// BlockingQueue and BlockingPriorityQueue. Adding items wakes only takers
+// and removing them wakes only putters; Close wakes both.
+type blockingQueue[T any, C blockingContainer[T]] struct {
+	mu       sync.Mutex
+	items    C
+	capacity int // 0 means unbounded
+	closed   bool
+	notEmpty waitSignal // broadcast when items are added, or on Close
+	notFull  waitSignal // broadcast when items are removed, or on Close
+}
+
+func (bq *blockingQueue[T, C]) full() bool {
+	return bq.capacity > 0 && bq.items.size() >= bq.capacity
+}
+
+// wait releases mu until signal is broadcast or ctx is done, returning ctx's
+// error in the latter case. mu must be held and is held again on return.
+func (bq *blockingQueue[T, C]) wait(ctx context.Context, signal *waitSignal) error {
+	ch := signal.channel()
+	bq.mu.Unlock()
+	defer bq.mu.Lock()
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (bq *blockingQueue[T, C]) put(ctx context.Context, val T) error {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	for !bq.closed && bq.full() {
+		if err := bq.wait(ctx, &bq.notFull); err != nil {
+			return err
+		}
+	}
+	if bq.closed {
+		return ErrQueueClosed
+	}
+	bq.items.push(val)
+	bq.notEmpty.broadcast()
+	return nil
+}
+
+func (bq *blockingQueue[T, C]) tryPut(val T) bool {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	if bq.closed || bq.full() {
+		return false
+	}
+	bq.items.push(val)
+	bq.notEmpty.broadcast()
+	return true
+}
+
+func (bq *blockingQueue[T, C]) take(ctx context.Context) (T, error) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	for bq.items.size() == 0 {
+		var zero T
+		if bq.closed {
+			return zero, ErrQueueClosed
+		}
+		if err := bq.wait(ctx, &bq.notEmpty); err != nil {
+			return zero, err
+		}
+	}
+	val := bq.items.pop()
+	bq.notFull.broadcast()
+	return val, nil
+}
+
+func (bq *blockingQueue[T, C]) tryTake() (T, bool) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	if bq.items.size() == 0 {
+		var zero T
+		return zero, false
+	}
+	val := bq.items.pop()
+	bq.notFull.broadcast()
+	return val, true
+}
+
+func (bq *blockingQueue[T, C]) drainTo(n int) []T {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	if n < 0 || n > bq.items.size() {
+		n = bq.items.size()
+	}
+	vals := make([]T, 0, n)
+	for len(vals) < n {
+		vals = append(vals, bq.items.pop())
+	}
+	if n > 0 {
+		bq.notFull.broadcast()
+	}
+	return vals
+}
+
+// Close stops the queue accepting items and wakes every blocked caller.
+// Items already queued can still be taken. Closing twice has no effect.
+func (bq *blockingQueue[T, C]) Close() {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	if !bq.closed {
+		bq.closed = true
+		bq.notEmpty.broadcast()
+		bq.notFull.broadcast()
+	}
+}
+
+// Closed returns whether Close has been called.
+func (bq *blockingQueue[T, C]) Closed() bool {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.closed
+}
+
+// Empty returns whether the queue is empty.
+func (bq *blockingQueue[T, C]) Empty() bool {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.items.size() == 0
+}
+
+// Size returns the number of items in the queue.
+func (bq *blockingQueue[T, C]) Size() int {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.items.size()
+}
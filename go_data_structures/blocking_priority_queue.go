@@ -0,0 +1,82 @@
+package go_data_structures
+
+import (
+	"context"
+
+	"golang.org/x/exp/constraints"
+)
+
+// BlockingPriorityQueue is a PriorityQueue that is safe for concurrent use.
+// Take blocks until an item is available and Offer blocks while the queue is
+// at capacity, both giving up when their context is done. After Close,
+// offers fail but the items already queued can still be taken.
+type BlockingPriorityQueue[T constraints.Ordered, V any] struct {
+	blockingQueue[Pair[T, V], priorityContainer[T, V]]
+}
+
+// priorityContainer serves a blockingQueue from a PriorityQueue.
+type priorityContainer[T constraints.Ordered, V any] struct {
+	queue *PriorityQueue[T, V]
+}
+
+func (pc priorityContainer[T, V]) push(item Pair[T, V]) {
+	pc.queue.Enqueue(item.Priority, item.Value)
+}
+
+func (pc priorityContainer[T, V]) pop() Pair[T, V] {
+	item, _ := pc.queue.Dequeue()
+	return item
+}
+
+func (pc priorityContainer[T, V]) size() int {
+	return pc.queue.Size()
+}
+
+// NewBlockingPriorityQueue creates a queue that serves the highest priority
+// first and holds at most capacity items, or any number if capacity is 0.
+func NewBlockingPriorityQueue[T constraints.Ordered, V any](capacity int) *BlockingPriorityQueue[T, V] {
+	return newBlockingPriorityQueue(NewPriorityQueue[T, V](), capacity)
+}
+
+// NewBlockingMinPriorityQueue is like NewBlockingPriorityQueue but serves
+// the lowest priority first.
+func NewBlockingMinPriorityQueue[T constraints.Ordered, V any](capacity int) *BlockingPriorityQueue[T, V] {
+	return newBlockingPriorityQueue(NewMinPriorityQueue[T, V](), capacity)
+}
+
+func newBlockingPriorityQueue[T constraints.Ordered, V any](queue *PriorityQueue[T, V], capacity int) *BlockingPriorityQueue[T, V] {
+	bq := &BlockingPriorityQueue[T, V]{}
+	bq.items = priorityContainer[T, V]{queue: queue}
+	bq.capacity = max(capacity, 0)
+	return bq
+}
+
+// Offer adds val with priority, blocking while the queue is full. It returns
+// ErrQueueClosed if the queue is closed, or ctx's error if ctx is done first.
+func (bq *BlockingPriorityQueue[T, V]) Offer(ctx context.Context, priority T, val V) error {
+	return bq.put(ctx, Pair[T, V]{Priority: priority, Value: val})
+}
+
+// TryOffer adds val with priority if there is room, without blocking, and
+// returns whether it did.
+func (bq *BlockingPriorityQueue[T, V]) TryOffer(priority T, val V) bool {
+	return bq.tryPut(Pair[T, V]{Priority: priority, Value: val})
+}
+
+// Take removes and returns the first item, blocking until there is one. It
+// returns ErrQueueClosed once the queue is closed and empty, or ctx's error
+// if ctx is done first.
+func (bq *BlockingPriorityQueue[T, V]) Take(ctx context.Context) (Pair[T, V], error) {
+	return bq.take(ctx)
+}
+
+// Poll removes and returns the first item without blocking, and false if the queue is empty.
+func (bq *BlockingPriorityQueue[T, V]) Poll() (Pair[T, V], bool) {
+	return bq.tryTake()
+}
+
+// DrainTo removes up to n items, or all of them if n is negative, and
+// returns them in priority order without blocking.
+func (bq *BlockingPriorityQueue[T, V]) DrainTo(n int) []Pair[T, V] {
+	return bq.drainTo(n)
+}
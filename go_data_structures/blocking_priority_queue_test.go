@@ -0,0 +1,183 @@
+package go_data_structures
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// assertBlocked fails if done delivers within a short grace period.
+func assertBlocked(t *testing.T, done <-chan error, what string) {
+	t.Helper()
+	select {
+	case err := <-done:
+		t.Fatalf("%s returned %v, want it blocked", what, err)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+// awaitResult returns what done delivers, failing if it takes too long.
+func awaitResult(t *testing.T, done <-chan error, what string) error {
+	t.Helper()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatalf("%s still blocked", what)
+		return nil
+	}
+}
+
+func TestBlockingPriorityQueueStress(t *testing.T) {
+	const producers, consumers, perProducer = 4, 4, 500
+	bq := NewBlockingPriorityQueue[int, int](4)
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				if err := bq.Offer(ctx, i, p*perProducer+i); err != nil {
+					t.Errorf("Offer: %v", err)
+					return
+				}
+			}
+		}(p)
+	}
+	var mu sync.Mutex
+	seen := make(map[int]bool)
+	var cw sync.WaitGroup
+	for c := 0; c < consumers; c++ {
+		cw.Add(1)
+		go func() {
+			defer cw.Done()
+			for {
+				item, err := bq.Take(ctx)
+				if errors.Is(err, ErrQueueClosed) {
+					return
+				}
+				if err != nil {
+					t.Errorf("Take: %v", err)
+					return
+				}
+				if n := bq.Size(); n > 4 {
+					t.Errorf("Size() = %d over capacity 4", n)
+				}
+				mu.Lock()
+				if seen[item.Value] {
+					t.Errorf("item %d taken twice", item.Value)
+				}
+				seen[item.Value] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	bq.Close()
+	cw.Wait()
+	if len(seen) != producers*perProducer {
+		t.Fatalf("took %d distinct items, want %d", len(seen), producers*perProducer)
+	}
+}
+
+func TestBlockingPriorityQueueBackPressure(t *testing.T) {
+	bq := NewBlockingPriorityQueue[int, string](2)
+	ctx := context.Background()
+	bq.TryOffer(1, "a")
+	bq.TryOffer(2, "b")
+	if bq.TryOffer(3, "c") {
+		t.Fatalf("TryOffer succeeded on a full queue")
+	}
+	done := make(chan error, 1)
+	go func() { done <- bq.Offer(ctx, 3, "c") }()
+	assertBlocked(t, done, "Offer on a full queue")
+	if item, err := bq.Take(ctx); err != nil || item.Value != "b" {
+		t.Fatalf("Take() = %v, %v, want b", item, err)
+	}
+	if err := awaitResult(t, done, "Offer after Take"); err != nil {
+		t.Fatalf("Offer: %v", err)
+	}
+
+	// DrainTo frees room too.
+	go func() { done <- bq.Offer(ctx, 0, "d") }()
+	assertBlocked(t, done, "Offer on a full queue")
+	if items := bq.DrainTo(1); len(items) != 1 || items[0].Value != "c" {
+		t.Fatalf("DrainTo(1) = %v, want [c]", items)
+	}
+	if err := awaitResult(t, done, "Offer after DrainTo"); err != nil {
+		t.Fatalf("Offer: %v", err)
+	}
+	items := bq.DrainTo(-1)
+	if len(items) != 2 || items[0].Value != "a" || items[1].Value != "d" {
+		t.Fatalf("DrainTo(-1) = %v, want [a d]", items)
+	}
+}
+
+func TestBlockingPriorityQueueCancel(t *testing.T) {
+	bq := NewBlockingPriorityQueue[int, int](1)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := bq.Take(ctx)
+		done <- err
+	}()
+	assertBlocked(t, done, "Take on an empty queue")
+	cancel()
+	if err := awaitResult(t, done, "cancelled Take"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Take after cancel: %v, want context.Canceled", err)
+	}
+
+	bq.TryOffer(1, 1)
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() { done <- bq.Offer(ctx, 2, 2) }()
+	assertBlocked(t, done, "Offer on a full queue")
+	cancel()
+	if err := awaitResult(t, done, "cancelled Offer"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Offer after cancel: %v, want context.Canceled", err)
+	}
+	if items := bq.DrainTo(-1); len(items) != 1 || items[0].Value != 1 {
+		t.Fatalf("cancelled Offer changed the queue: %v", items)
+	}
+}
+
+func TestBlockingPriorityQueueClose(t *testing.T) {
+	bq := NewBlockingMinPriorityQueue[int, int](0)
+	ctx := context.Background()
+	done := make(chan error, 1)
+	go func() {
+		_, err := bq.Take(ctx)
+		done <- err
+	}()
+	assertBlocked(t, done, "Take on an empty queue")
+	bq.Close()
+	if err := awaitResult(t, done, "Take after Close"); !errors.Is(err, ErrQueueClosed) {
+		t.Fatalf("Take after Close: %v, want ErrQueueClosed", err)
+	}
+
+	bq = NewBlockingMinPriorityQueue[int, int](0)
+	for _, p := range []int{3, 1, 2} {
+		bq.TryOffer(p, p*10)
+	}
+	bq.Close()
+	bq.Close()
+	if err := bq.Offer(ctx, 0, 0); !errors.Is(err, ErrQueueClosed) {
+		t.Fatalf("Offer after Close: %v, want ErrQueueClosed", err)
+	}
+	if bq.TryOffer(0, 0) || !bq.Closed() {
+		t.Fatalf("TryOffer succeeded after Close")
+	}
+	for _, want := range []int{10, 20, 30} {
+		if item, err := bq.Take(ctx); err != nil || item.Value != want {
+			t.Fatalf("Take() after Close = %v, %v, want %d", item, err, want)
+		}
+	}
+	if _, err := bq.Take(ctx); !errors.Is(err, ErrQueueClosed) {
+		t.Fatalf("Take on closed, drained queue: %v, want ErrQueueClosed", err)
+	}
+	if _, ok := bq.Poll(); ok {
+		t.Fatalf("Poll on closed, drained queue succeeded")
+	}
+}
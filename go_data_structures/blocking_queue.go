@@ -0,0 +1,131 @@
+package go_data_structures
+
+import (
+	"context"
+)
+
+// BlockingQueue is a FIFO queue that is safe for concurrent use. Take blocks
+// until an item is available and Put blocks while the queue is at capacity,
+// both giving up when their context is done. After Close, puts fail but the
+// items already queued can still be taken. Chan and PutAll connect it to
+// channels for use with select.
+type BlockingQueue[T any] struct {
+	blockingQueue[T, fifoContainer[T]]
+}
+
+// fifoContainer serves a blockingQueue from a RingDeque, oldest first.
+type fifoContainer[T any] struct {
+	deque *RingDeque[T]
+}
+
+func (fc fifoContainer[T]) push(val T) {
+	fc.deque.PushBack(val)
+}
+
+func (fc fifoContainer[T]) pop() T {
+	val, _ := fc.deque.PopFront()
+	return val
+}
+
+func (fc fifoContainer[T]) size() int {
+	return fc.deque.Size()
+}
+
+// NewBlockingQueue creates a queue holding at most capacity items, or any
+// number if capacity is 0.
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	bq := &BlockingQueue[T]{}
+	bq.items = fifoContainer[T]{deque: NewRingDeque[T]()}
+	bq.capacity = max(capacity, 0)
+	return bq
+}
+
+// Put adds val to the back, blocking while the queue is full. It returns
+// ErrQueueClosed if the queue is closed, or ctx's error if ctx is done first.
+func (bq *BlockingQueue[T]) Put(ctx context.Context, val T) error {
+	return bq.put(ctx, val)
+}
+
+// TryPut adds val to the back if there is room, without blocking, and
+// returns whether it did.
+func (bq *BlockingQueue[T]) TryPut(val T) bool {
+	return bq.tryPut(val)
+}
+
+// Take removes and returns the front item, blocking until there is one. It
+// returns ErrQueueClosed once the queue is closed and empty, or ctx's error
+// if ctx is done first.
+func (bq *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	return bq.take(ctx)
+}
+
+// TryTake removes and returns the front item without blocking, and false if the queue is empty.
+func (bq *BlockingQueue[T]) TryTake() (T, bool) {
+	return bq.tryTake()
+}
+
+// Front returns the front item without removing it, and false if the queue is empty.
+func (bq *BlockingQueue[T]) Front() (T, bool) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.items.deque.At(0)
+}
+
+// DrainTo removes up to n items, or all of them if n is negative, and
+// returns them in order without blocking.
+func (bq *BlockingQueue[T]) DrainTo(n int) []T {
+	return bq.drainTo(n)
+}
+
+// Chan returns a channel that receives the queue's items in order. A
+// goroutine feeds it until the queue is closed and drained or ctx is done,
+// then closes it. The item the goroutine is waiting to hand over has
+// already left the queue; if ctx is done first, it goes back to the front,
+// which may leave the queue one over capacity.
+func (bq *BlockingQueue[T]) Chan(ctx context.Context) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			val, err := bq.Take(ctx)
+			if err != nil {
+				return
+			}
+			select {
+			case out <- val:
+			case <-ctx.Done():
+				bq.requeue(val)
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// requeue puts val back at the front of the queue, ignoring capacity and
+// Close, since it was already accepted once.
+func (bq *BlockingQueue[T]) requeue(val T) {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	bq.items.deque.PushFront(val)
+	bq.notEmpty.broadcast()
+}
+
+// PutAll puts every value received from in until in is closed, returning
+// nil then, ErrQueueClosed if the queue is closed, or ctx's error if ctx is
+// done first. It blocks, so callers usually run it in its own goroutine.
+func (bq *BlockingQueue[T]) PutAll(ctx context.Context, in <-chan T) error {
+	for {
+		select {
+		case val, ok := <-in:
+			if !ok {
+				return nil
+			}
+			if err := bq.Put(ctx, val); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package go_data_structures
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBlockingQueueOrder(t *testing.T) {
+	bq := NewBlockingQueue[int](0)
+	ctx := context.Background()
+	for i := 1; i <= 5; i++ {
+		if err := bq.Put(ctx, i); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+	}
+	if v, ok := bq.Front(); !ok || v != 1 {
+		t.Fatalf("Front() = %d, %v, want 1", v, ok)
+	}
+	if v, err := bq.Take(ctx); err != nil || v != 1 {
+		t.Fatalf("Take() = %d, %v, want 1", v, err)
+	}
+	if v, ok := bq.TryTake(); !ok || v != 2 {
+		t.Fatalf("TryTake() = %d, %v, want 2", v, ok)
+	}
+	bq.Close()
+	if bq.TryPut(6) || !errors.Is(bq.Put(ctx, 6), ErrQueueClosed) {
+		t.Fatalf("Put succeeded after Close")
+	}
+	if got := bq.DrainTo(-1); !reflect.DeepEqual(got, []int{3, 4, 5}) {
+		t.Fatalf("DrainTo(-1) = %v, want [3 4 5]", got)
+	}
+	if _, err := bq.Take(ctx); !errors.Is(err, ErrQueueClosed) {
+		t.Fatalf("Take on closed, drained queue: %v, want ErrQueueClosed", err)
+	}
+}
+
+func TestBlockingQueueChanCancelKeepsItem(t *testing.T) {
+	bq := NewBlockingQueue[int](2)
+	bq.TryPut(1)
+	bq.TryPut(2)
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := bq.Chan(ctx)
+	if v := <-ch; v != 1 {
+		t.Fatalf("first item from Chan = %d, want 1", v)
+	}
+	// The feeder now holds 2, waiting for a receiver that never comes.
+	cancel()
+	deadline := time.Now().Add(5 * time.Second)
+	for bq.Size() != 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("cancelled Chan lost the item it held")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if v, ok := <-ch; ok {
+		t.Fatalf("Chan delivered %d after cancel", v)
+	}
+	if got := bq.DrainTo(-1); !reflect.DeepEqual(got, []int{2}) {
+		t.Fatalf("after cancel the queue holds %v, want [2]", got)
+	}
+}
+
+func TestBlockingQueuePutAll(t *testing.T) {
+	bq := NewBlockingQueue[int](0)
+	in := make(chan int)
+	go func() {
+		for i := 0; i < 100; i++ {
+			in <- i
+		}
+		close(in)
+	}()
+	if err := bq.PutAll(context.Background(), in); err != nil {
+		t.Fatalf("PutAll: %v", err)
+	}
+	bq.Close()
+	sum := 0
+	for v := range bq.Chan(context.Background()) {
+		sum += v
+	}
+	if sum != 4950 || !bq.Empty() {
+		t.Fatalf("Chan delivered sum %d, want 4950", sum)
+	}
+}
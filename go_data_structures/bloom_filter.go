@@ -0,0 +1,158 @@
+package go_data_structures
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// BloomFilter answers "definitely not present" or "probably present" for a
+// set of values using k hash positions per value in an m-bit Bitset. The k
+// positions come from double hashing: h1 + i*h2, both derived from one
+// HashFunc call.
+type BloomFilter[T any] struct {
+	bits   *Bitset
+	m      uint // number of bits
+	k      uint // number of hash positions per value
+	count  uint // number of Add calls, used for estimates
+	hasher HashFunc[T]
+}
+
+// NewBloomFilter creates a BloomFilter sized for n values at a target false
+// positive rate fpRate, using DefaultHash.
+func NewBloomFilter[T any](n uint, fpRate float64) *BloomFilter[T] {
+	return NewBloomFilterWithHash[T](n, fpRate, DefaultHash[T])
+}
+
+// NewBloomFilterWithHash is NewBloomFilter with a caller-supplied hash.
+func NewBloomFilterWithHash[T any](n uint, fpRate float64, hasher HashFunc[T]) *BloomFilter[T] {
+	m, k := bloomParameters(n, fpRate)
+	return &BloomFilter[T]{bits: NewBitset(m), m: m, k: k, hasher: hasher}
+}
+
+// bloomParameters returns the optimal bit count m = -n ln p / (ln 2)^2 and
+// hash count k = (m/n) ln 2 for n values at false positive rate p.
+func bloomParameters(n uint, p float64) (uint, uint) {
+	n = max(n, 1)
+	p = math.Min(math.Max(p, 1e-12), 0.5)
+	m := uint(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	k := uint(math.Round(float64(m) / float64(n) * math.Ln2))
+	return max(m, 1), max(k, 1)
+}
+
+// positions calls fn with each of the k bit positions for val.
+func (bf *BloomFilter[T]) positions(val T, fn func(pos uint) bool) {
+	h1 := bf.hasher(val)
+	h2 := mix64(h1) | 1 // odd, so the k positions never collapse onto one
+	for i := uint(0); i < bf.k; i++ {
+		if !fn(uint((h1 + uint64(i)*h2) % uint64(bf.m))) {
+			return
+		}
+	}
+}
+
+// Add records val in the filter. O(k)
+func (bf *BloomFilter[T]) Add(val T) {
+	bf.positions(val, func(pos uint) bool {
+		bf.bits.Set(pos)
+		return true
+	})
+	bf.count++
+}
+
+// Contains returns false if val was definitely never added, and true if it
+// probably was. O(k)
+func (bf *BloomFilter[T]) Contains(val T) bool {
+	found := true
+	bf.positions(val, func(pos uint) bool {
+		found = bf.bits.Test(pos)
+		return found
+	})
+	return found
+}
+
+// Union adds every value recorded in other to bf. Both filters must have
+// the same size and hash count, and must use the same HashFunc.
+func (bf *BloomFilter[T]) Union(other *BloomFilter[T]) error {
+	if bf.m != other.m || bf.k != other.k {
+		return errors.New("bloom filter: union of filters with different parameters")
+	}
+	bf.bits.InPlaceOr(other.bits)
+	bf.count += other.count
+	return nil
+}
+
+// Clear removes every value from the filter.
+func (bf *BloomFilter[T]) Clear() {
+	bf.bits = NewBitset(bf.m)
+	bf.count = 0
+}
+
+// Count returns how many values have been added, including duplicates.
+func (bf *BloomFilter[T]) Count() uint {
+	return bf.count
+}
+
+// FillRatio returns the fraction of bits that are set.
+func (bf *BloomFilter[T]) FillRatio() float64 {
+	return float64(bf.bits.Count()) / float64(bf.m)
+}
+
+// EstimatedFalsePositiveRate returns the current false positive probability,
+// estimated as FillRatio^k.
+func (bf *BloomFilter[T]) EstimatedFalsePositiveRate() float64 {
+	return math.Pow(bf.FillRatio(), float64(bf.k))
+}
+
+// EstimatedCount estimates the number of distinct values added from the
+// number of set bits: -(m/k) ln(1 - X/m).
+func (bf *BloomFilter[T]) EstimatedCount() float64 {
+	fill := bf.FillRatio()
+	if fill >= 1 {
+		return math.Inf(1)
+	}
+	return -float64(bf.m) / float64(bf.k) * math.Log(1-fill)
+}
+
+// MarshalBinary encodes the filter as little-endian m, k, count followed by
+// the bit words. The HashFunc is not encoded; decode with the same one.
+func (bf *BloomFilter[T]) MarshalBinary() ([]byte, error) {
+	le := binary.LittleEndian
+	words := (bf.m + wordSize - 1) / wordSize
+	buf := make([]byte, 0, 24+8*words)
+	buf = le.AppendUint64(buf, uint64(bf.m))
+	buf = le.AppendUint64(buf, uint64(bf.k))
+	buf = le.AppendUint64(buf, uint64(bf.count))
+	for i := uint(0); i < words; i++ {
+		var word uint64
+		if i < uint(len(bf.bits.words)) {
+			word = bf.bits.words[i]
+		}
+		buf = le.AppendUint64(buf, word)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary replaces the filter's contents with data from MarshalBinary,
+// keeping the receiver's HashFunc.
+func (bf *BloomFilter[T]) UnmarshalBinary(data []byte) error {
+	le := binary.LittleEndian
+	if len(data) < 24 {
+		return errors.New("bloom filter: truncated input")
+	}
+	m, k, count := le.Uint64(data), le.Uint64(data[8:]), le.Uint64(data[16:])
+	words := (m + wordSize - 1) / wordSize
+	if m == 0 || k == 0 || uint64(len(data)-24) != 8*words {
+		return errors.New("bloom filter: corrupt input")
+	}
+	bits := NewBitset(uint(m))
+	for i := range bits.words {
+		bits.words[i] = le.Uint64(data[24+8*i:])
+	}
+	bits.recount()
+	bf.bits, bf.m, bf.k, bf.count = bits, uint(m), uint(k), uint(count)
+	if bf.hasher == nil {
+		bf.hasher = DefaultHash[T]
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package go_data_structures
+
+import (
+	"testing"
+)
+
+func TestBloomFilterFalsePositiveRate(t *testing.T) {
+	for _, p := range []float64{0.1, 0.01, 0.001} {
+		const n = 10000
+		bf := NewBloomFilter[int](n, p)
+		for i := 0; i < n; i++ {
+			bf.Add(i)
+		}
+		for i := 0; i < n; i++ {
+			if !bf.Contains(i) {
+				t.Fatalf("p=%v: false negative for %d", p, i)
+			}
+		}
+		const probes = 200000
+		hits := 0
+		for i := n; i < n+probes; i++ {
+			if bf.Contains(i) {
+				hits++
+			}
+		}
+		// At the configured load the rate should sit near p; allow for
+		// rounding of m and k and for sampling noise.
+		if rate := float64(hits) / probes; rate > 1.5*p {
+			t.Errorf("p=%v: empirical false positive rate %.5f", p, rate)
+		}
+		if est := bf.EstimatedFalsePositiveRate(); est > 1.5*p || est < p/1.5 {
+			t.Errorf("p=%v: EstimatedFalsePositiveRate() = %.5f", p, est)
+		}
+		if est := bf.EstimatedCount(); est < 0.95*n || est > 1.05*n {
+			t.Errorf("p=%v: EstimatedCount() = %.0f, want about %d", p, est, n)
+		}
+	}
+}
+
+func TestBloomFilterUnion(t *testing.T) {
+	a, b := NewBloomFilter[int](1000, 0.01), NewBloomFilter[int](1000, 0.01)
+	for i := 0; i < 500; i++ {
+		a.Add(i)
+		b.Add(i + 500)
+	}
+	if err := a.Union(b); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 1000; i++ {
+		if !a.Contains(i) {
+			t.Fatalf("union lost %d", i)
+		}
+	}
+	if a.Count() != 1000 {
+		t.Fatalf("Count() after union = %d, want 1000", a.Count())
+	}
+
+	for _, other := range []*BloomFilter[int]{
+		NewBloomFilter[int](2000, 0.01), // more bits
+		NewBloomFilter[int](1000, 0.1),  // fewer bits and hashes
+	} {
+		before, _ := a.MarshalBinary()
+		if err := a.Union(other); err == nil {
+			t.Fatalf("Union accepted a filter with m=%d, k=%d (have m=%d, k=%d)", other.m, other.k, a.m, a.k)
+		}
+		if after, _ := a.MarshalBinary(); string(after) != string(before) {
+			t.Fatal("a failed Union changed the filter")
+		}
+	}
+}
+
+func TestBloomFilterMarshalBinary(t *testing.T) {
+	bf := NewBloomFilter[string](300, 0.02)
+	words := []string{"alpha", "beta", "gamma", "delta", "epsilon"}
+	for _, w := range words {
+		bf.Add(w)
+	}
+	data, err := bf.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded BloomFilter[string]
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.m != bf.m || decoded.k != bf.k || decoded.Count() != bf.Count() || decoded.FillRatio() != bf.FillRatio() {
+		t.Fatalf("decoded m=%d k=%d count=%d, want m=%d k=%d count=%d",
+			decoded.m, decoded.k, decoded.Count(), bf.m, bf.k, bf.Count())
+	}
+	for _, w := range words {
+		if !decoded.Contains(w) {
+			t.Fatalf("decoded filter lost %q", w)
+		}
+	}
+	for cut := 0; cut < len(data); cut++ {
+		if decoded.UnmarshalBinary(data[:cut]) == nil {
+			t.Fatalf("UnmarshalBinary accepted %d of %d bytes", cut, len(data))
+		}
+	}
+}
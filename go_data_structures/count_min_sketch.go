@@ -0,0 +1,101 @@
+package go_data_structures
+
+import (
+	"errors"
+	"math"
+)
+
+// CountMinSketch estimates how often each value occurs in a stream using a
+// depth x width table of counters. Estimates never undercount. With width
+// ceil(e/epsilon) and depth ceil(ln(1/delta)), an estimate exceeds the true
+// count by more than epsilon*Total() with probability at most delta.
+//
+// With conservative update, Add only raises the counters that are below the
+// new estimate. This keeps the same guarantee but overcounts far less on
+// skewed streams; it does not support decrements.
+type CountMinSketch[T any] struct {
+	width        uint
+	depth        uint
+	counts       [][]uint64
+	total        uint64
+	conservative bool
+	hasher       HashFunc[T]
+}
+
+// NewCountMinSketch creates a sketch with error at most epsilon*Total() with
+// probability 1-delta, using DefaultHash.
+func NewCountMinSketch[T any](epsilon, delta float64, conservative bool) *CountMinSketch[T] {
+	return NewCountMinSketchWithHash[T](epsilon, delta, conservative, DefaultHash[T])
+}
+
+// NewCountMinSketchWithHash is NewCountMinSketch with a caller-supplied hash.
+func NewCountMinSketchWithHash[T any](epsilon, delta float64, conservative bool, hasher HashFunc[T]) *CountMinSketch[T] {
+	width := uint(math.Ceil(math.E / epsilon))
+	depth := uint(math.Ceil(math.Log(1 / delta)))
+	width, depth = max(width, 1), max(depth, 1)
+	counts := make([][]uint64, depth)
+	for i := range counts {
+		counts[i] = make([]uint64, width)
+	}
+	return &CountMinSketch[T]{width: width, depth: depth, counts: counts, conservative: conservative, hasher: hasher}
+}
+
+// columns calls fn with the counter column of val in each row, using double
+// hashing from a single HashFunc call.
+func (cms *CountMinSketch[T]) columns(val T, fn func(row, col uint)) {
+	h1 := cms.hasher(val)
+	h2 := mix64(h1) | 1
+	for row := uint(0); row < cms.depth; row++ {
+		fn(row, uint((h1+uint64(row)*h2)%uint64(cms.width)))
+	}
+}
+
+// Add records count more occurrences of val. O(depth)
+func (cms *CountMinSketch[T]) Add(val T, count uint64) {
+	cms.total += count
+	if !cms.conservative {
+		cms.columns(val, func(row, col uint) {
+			cms.counts[row][col] += count
+		})
+		return
+	}
+	target := cms.Estimate(val) + count
+	cms.columns(val, func(row, col uint) {
+		cms.counts[row][col] = max(cms.counts[row][col], target)
+	})
+}
+
+// Estimate returns an upper bound on the number of occurrences of val. O(depth)
+func (cms *CountMinSketch[T]) Estimate(val T) uint64 {
+	estimate := uint64(math.MaxUint64)
+	cms.columns(val, func(row, col uint) {
+		estimate = min(estimate, cms.counts[row][col])
+	})
+	return estimate
+}
+
+// Total returns the sum of all counts added.
+func (cms *CountMinSketch[T]) Total() uint64 {
+	return cms.total
+}
+
+// ErrorBound returns the additive error that estimates stay within with
+// probability 1-delta: e/width * Total().
+func (cms *CountMinSketch[T]) ErrorBound() float64 {
+	return math.E / float64(cms.width) * float64(cms.total)
+}
+
+// Merge adds other's counts into cms, so cms sketches both streams. Both
+// sketches must have the same dimensions and HashFunc.
+func (cms *CountMinSketch[T]) Merge(other *CountMinSketch[T]) error {
+	if cms.width != other.width || cms.depth != other.depth {
+		return errors.New("count-min sketch: merge of sketches with different dimensions")
+	}
+	for row := range cms.counts {
+		for col := range cms.counts[row] {
+			cms.counts[row][col] += other.counts[row][col]
+		}
+	}
+	cms.total += other.total
+	return nil
+}
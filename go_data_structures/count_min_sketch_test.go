@@ -0,0 +1,56 @@
+package go_data_structures
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestCountMinSketchErrorBound(t *testing.T) {
+	const universe, stream = 20000, 100000
+	for _, conservative := range []bool{false, true} {
+		r := rand.New(rand.NewSource(5))
+		cms := NewCountMinSketchWithHash[int](0.001, 0.01, conservative, testHash)
+		truth := make(map[int]uint64)
+		for i := 0; i < stream; i++ {
+			// Skewed toward small values, like most real frequency streams.
+			x := int(math.Pow(r.Float64(), 3) * universe)
+			cms.Add(x, 1)
+			truth[x]++
+		}
+		if cms.Total() != stream {
+			t.Fatalf("Total() = %d, want %d", cms.Total(), stream)
+		}
+		over := 0
+		for x := 0; x < universe; x++ {
+			est := cms.Estimate(x)
+			if est < truth[x] {
+				t.Fatalf("conservative=%v: Estimate(%d) = %d undercounts %d", conservative, x, est, truth[x])
+			}
+			if float64(est-truth[x]) > cms.ErrorBound() {
+				over++
+			}
+		}
+		// delta = 0.01: allow at most 1% of queries past the bound.
+		if over > universe/100 {
+			t.Fatalf("conservative=%v: %d of %d estimates exceed ErrorBound %.1f", conservative, over, universe, cms.ErrorBound())
+		}
+	}
+}
+
+func TestCountMinSketchMerge(t *testing.T) {
+	a := NewCountMinSketchWithHash[int](0.01, 0.01, false, testHash)
+	b := NewCountMinSketchWithHash[int](0.01, 0.01, false, testHash)
+	a.Add(1, 5)
+	b.Add(1, 7)
+	b.Add(2, 3)
+	if err := a.Merge(b); err != nil {
+		t.Fatalf("Merge: %v", err)
+	}
+	if a.Total() != 15 || a.Estimate(1) < 12 || a.Estimate(2) < 3 {
+		t.Fatalf("after Merge: Total %d, Estimate(1) %d, Estimate(2) %d", a.Total(), a.Estimate(1), a.Estimate(2))
+	}
+	if err := a.Merge(NewCountMinSketchWithHash[int](0.1, 0.01, false, testHash)); err == nil {
+		t.Fatalf("Merge of different dimensions succeeded")
+	}
+}
@@ -0,0 +1,222 @@
+package go_data_structures
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"math/rand"
+)
+
+const (
+	cuckooBucketSize = 4   // fingerprints per bucket
+	cuckooMaxKicks   = 500 // relocations tried before Add gives up
+)
+
+// cuckooBucket holds up to cuckooBucketSize 16-bit fingerprints; 0 marks an empty slot.
+type cuckooBucket [cuckooBucketSize]uint16
+
+// cuckooSlot locates one fingerprint, used to undo a failed relocation walk.
+type cuckooSlot struct {
+	bucket uint
+	slot   int
+}
+
+// CuckooFilter is an approximate membership filter like BloomFilter that
+// also supports deletion. Each value is stored as a 16-bit fingerprint in
+// one of two candidate buckets; i2 = i1 XOR hash(fingerprint), so either
+// bucket can be computed from the other when relocating.
+type CuckooFilter[T any] struct {
+	buckets []cuckooBucket
+	count   uint
+	hasher  HashFunc[T]
+	rng     *rand.Rand
+}
+
+// NewCuckooFilter creates a CuckooFilter with room for about capacity
+// values, using DefaultHash.
+func NewCuckooFilter[T any](capacity uint) *CuckooFilter[T] {
+	return NewCuckooFilterWithHash[T](capacity, DefaultHash[T])
+}
+
+// NewCuckooFilterWithHash is NewCuckooFilter with a caller-supplied hash.
+func NewCuckooFilterWithHash[T any](capacity uint, hasher HashFunc[T]) *CuckooFilter[T] {
+	// The bucket count must be a power of two so the XOR of two indexes stays in range.
+	n := max((capacity+cuckooBucketSize-1)/cuckooBucketSize, 1)
+	n = 1 << bits.Len(n-1)
+	return &CuckooFilter[T]{
+		buckets: make([]cuckooBucket, n),
+		hasher:  hasher,
+		rng:     rand.New(rand.NewSource(1)),
+	}
+}
+
+// fingerprintAndIndex derives the fingerprint and first bucket of val.
+func (cf *CuckooFilter[T]) fingerprintAndIndex(val T) (uint16, uint) {
+	h := cf.hasher(val)
+	fp := uint16(mix64(h) >> 48)
+	if fp == 0 {
+		fp = 1
+	}
+	return fp, uint(h) & uint(len(cf.buckets)-1)
+}
+
+// altIndex returns the other candidate bucket for fingerprint fp stored at i.
+func (cf *CuckooFilter[T]) altIndex(i uint, fp uint16) uint {
+	return (i ^ uint(mix64(uint64(fp)))) & uint(len(cf.buckets)-1)
+}
+
+func (b *cuckooBucket) insert(fp uint16) bool {
+	for i, slot := range b {
+		if slot == 0 {
+			b[i] = fp
+			return true
+		}
+	}
+	return false
+}
+
+func (b *cuckooBucket) remove(fp uint16) bool {
+	for i, slot := range b {
+		if slot == fp {
+			b[i] = 0
+			return true
+		}
+	}
+	return false
+}
+
+func (b *cuckooBucket) contains(fp uint16) bool {
+	for _, slot := range b {
+		if slot == fp {
+			return true
+		}
+	}
+	return false
+}
+
+// Add inserts val, returning false if the filter is too full to place it.
+// Adding the same value twice stores two fingerprints, so it must be
+// removed twice.
+func (cf *CuckooFilter[T]) Add(val T) bool {
+	fp, i1 := cf.fingerprintAndIndex(val)
+	i2 := cf.altIndex(i1, fp)
+	if cf.buckets[i1].insert(fp) || cf.buckets[i2].insert(fp) {
+		cf.count++
+		return true
+	}
+	// Both buckets are full: evict fingerprints along a random walk until one fits.
+	i := i1
+	if cf.rng.Intn(2) == 0 {
+		i = i2
+	}
+	var evicted []cuckooSlot
+	for kick := 0; kick < cuckooMaxKicks; kick++ {
+		slot := cf.rng.Intn(cuckooBucketSize)
+		fp, cf.buckets[i][slot] = cf.buckets[i][slot], fp
+		evicted = append(evicted, cuckooSlot{bucket: i, slot: slot})
+		i = cf.altIndex(i, fp)
+		if cf.buckets[i].insert(fp) {
+			cf.count++
+			return true
+		}
+	}
+	// Undo the walk so a failed Add leaves every existing value in place.
+	for j := len(evicted) - 1; j >= 0; j-- {
+		e := evicted[j]
+		fp, cf.buckets[e.bucket][e.slot] = cf.buckets[e.bucket][e.slot], fp
+	}
+	return false
+}
+
+// Contains returns false if val is definitely absent, and true if it is
+// probably present. O(1)
+func (cf *CuckooFilter[T]) Contains(val T) bool {
+	fp, i1 := cf.fingerprintAndIndex(val)
+	return cf.buckets[i1].contains(fp) || cf.buckets[cf.altIndex(i1, fp)].contains(fp)
+}
+
+// Remove deletes one occurrence of val, returning whether a matching
+// fingerprint was found. Only remove values that were actually added, or an
+// unrelated value sharing the fingerprint may be removed instead.
+func (cf *CuckooFilter[T]) Remove(val T) bool {
+	fp, i1 := cf.fingerprintAndIndex(val)
+	if cf.buckets[i1].remove(fp) || cf.buckets[cf.altIndex(i1, fp)].remove(fp) {
+		cf.count--
+		return true
+	}
+	return false
+}
+
+// Count returns the number of stored fingerprints.
+func (cf *CuckooFilter[T]) Count() uint {
+	return cf.count
+}
+
+// Capacity returns the number of fingerprint slots.
+func (cf *CuckooFilter[T]) Capacity() uint {
+	return uint(len(cf.buckets)) * cuckooBucketSize
+}
+
+// FillRatio returns the fraction of fingerprint slots in use.
+func (cf *CuckooFilter[T]) FillRatio() float64 {
+	return float64(cf.count) / float64(cf.Capacity())
+}
+
+// EstimatedFalsePositiveRate returns the current false positive probability.
+// A lookup compares against at most 2*bucketSize occupied slots, each
+// matching by chance with probability 1/(2^16 - 1).
+func (cf *CuckooFilter[T]) EstimatedFalsePositiveRate() float64 {
+	return 2 * cuckooBucketSize * cf.FillRatio() / float64(1<<16-1)
+}
+
+// MarshalBinary encodes the filter as little-endian bucket count and
+// fingerprint count followed by the fingerprints. The HashFunc is not
+// encoded; decode with the same one.
+func (cf *CuckooFilter[T]) MarshalBinary() ([]byte, error) {
+	le := binary.LittleEndian
+	buf := make([]byte, 0, 16+2*cuckooBucketSize*len(cf.buckets))
+	buf = le.AppendUint64(buf, uint64(len(cf.buckets)))
+	buf = le.AppendUint64(buf, uint64(cf.count))
+	for _, b := range cf.buckets {
+		for _, fp := range b {
+			buf = le.AppendUint16(buf, fp)
+		}
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary replaces the filter's contents with data from MarshalBinary,
+// keeping the receiver's HashFunc.
+func (cf *CuckooFilter[T]) UnmarshalBinary(data []byte) error {
+	le := binary.LittleEndian
+	if len(data) < 16 {
+		return errors.New("cuckoo filter: truncated input")
+	}
+	n, count := le.Uint64(data), le.Uint64(data[8:])
+	if n == 0 || n&(n-1) != 0 || uint64(len(data)-16) != 2*cuckooBucketSize*n {
+		return errors.New("cuckoo filter: corrupt input")
+	}
+	buckets := make([]cuckooBucket, n)
+	stored := uint64(0)
+	pos := 16
+	for i := range buckets {
+		for j := range buckets[i] {
+			buckets[i][j] = le.Uint16(data[pos:])
+			if buckets[i][j] != 0 {
+				stored++
+			}
+			pos += 2
+		}
+	}
+	if stored != count {
+		return errors.New("cuckoo filter: corrupt input")
+	}
+	cf.buckets, cf.count = buckets, uint(count)
+	if cf.hasher == nil {
+		cf.hasher = DefaultHash[T]
+	}
+	if cf.rng == nil {
+		cf.rng = rand.New(rand.NewSource(1))
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package go_data_structures
+
+import (
+	"reflect"
+	"testing"
+)
+
+// fillCuckoo adds consecutive values from 0 until Add fails, returning how
+// many were added.
+func fillCuckoo(cf *CuckooFilter[int]) int {
+	n := 0
+	for cf.Add(n) {
+		n++
+	}
+	return n
+}
+
+func TestCuckooFilterFailedAddKeepsValues(t *testing.T) {
+	cf := NewCuckooFilter[int](1000)
+	n := fillCuckoo(cf)
+	if n < int(cf.Capacity())*9/10 {
+		t.Fatalf("Add failed after %d of %d slots", n, cf.Capacity())
+	}
+	if cf.Count() != uint(n) {
+		t.Fatalf("Count() = %d, want %d", cf.Count(), n)
+	}
+	// Further Adds fail after a full relocation walk, which must be undone.
+	added := make([]int, n)
+	for v := range added {
+		added[v] = v
+	}
+	for v := n; v < n+20; v++ {
+		before := append([]cuckooBucket(nil), cf.buckets...)
+		if cf.Add(v) {
+			added = append(added, v) // found a slot after all
+			continue
+		}
+		if !reflect.DeepEqual(cf.buckets, before) {
+			t.Fatalf("failed Add(%d) moved fingerprints", v)
+		}
+	}
+	for _, v := range added {
+		if !cf.Contains(v) {
+			t.Fatalf("false negative for %d after failed Adds", v)
+		}
+	}
+}
+
+func TestCuckooFilterRemove(t *testing.T) {
+	cf := NewCuckooFilter[int](4096)
+	const n = 3000
+	for i := 0; i < n; i++ {
+		if !cf.Add(i) {
+			t.Fatalf("Add(%d) failed at %.2f fill", i, cf.FillRatio())
+		}
+	}
+	for i := 0; i < n; i += 2 {
+		if !cf.Remove(i) {
+			t.Fatalf("Remove(%d) found nothing", i)
+		}
+	}
+	if cf.Count() != n/2 {
+		t.Fatalf("Count() = %d, want %d", cf.Count(), n/2)
+	}
+	stale := 0
+	for i := 0; i < n; i++ {
+		if i%2 == 1 && !cf.Contains(i) {
+			t.Fatalf("false negative for %d after removing its neighbours", i)
+		}
+		if i%2 == 0 && cf.Contains(i) {
+			stale++ // only a fingerprint collision can keep it
+		}
+	}
+	if stale > 2 {
+		t.Fatalf("%d removed values still reported present", stale)
+	}
+
+	// A value added twice needs two Removes.
+	cf.Add(-1)
+	cf.Add(-1)
+	if !cf.Remove(-1) || !cf.Contains(-1) {
+		t.Fatal("one Remove dropped both copies")
+	}
+	if !cf.Remove(-1) {
+		t.Fatal("second Remove found nothing")
+	}
+	if cf.Count() != n/2 {
+		t.Fatalf("Count() = %d, want %d", cf.Count(), n/2)
+	}
+}
+
+func TestCuckooFilterFalsePositiveRate(t *testing.T) {
+	cf := NewCuckooFilter[int](1 << 14)
+	n := fillCuckoo(cf)
+	for v := 0; v < n; v++ {
+		if !cf.Contains(v) {
+			t.Fatalf("false negative for %d", v)
+		}
+	}
+	const probes = 500000
+	hits := 0
+	for v := n + 1; v <= n+probes; v++ {
+		if cf.Contains(v) {
+			hits++
+		}
+	}
+	// About 8/65535 at full load; allow for sampling noise on ~60 hits.
+	rate, est := float64(hits)/probes, cf.EstimatedFalsePositiveRate()
+	if rate > 1.5*est {
+		t.Fatalf("empirical false positive rate %.6f, estimated %.6f", rate, est)
+	}
+}
+
+func TestCuckooFilterMarshalBinary(t *testing.T) {
+	cf := NewCuckooFilter[int](500)
+	for i := 0; i < 300; i++ {
+		cf.Add(i * 7)
+	}
+	data, err := cf.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded CuckooFilter[int]
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Count() != cf.Count() || !reflect.DeepEqual(decoded.buckets, cf.buckets) {
+		t.Fatalf("decoded %d fingerprints, want %d", decoded.Count(), cf.Count())
+	}
+	for i := 0; i < 300; i++ {
+		if !decoded.Contains(i * 7) {
+			t.Fatalf("decoded filter lost %d", i*7)
+		}
+	}
+	// The decoded filter is fully usable, including its relocation walk.
+	fillCuckoo(&decoded)
+	for cut := 0; cut < len(data); cut += 1 + len(data)/50 {
+		if decoded.UnmarshalBinary(data[:cut]) == nil {
+			t.Fatalf("UnmarshalBinary accepted %d of %d bytes", cut, len(data))
+		}
+	}
+}
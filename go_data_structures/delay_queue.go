@@ -0,0 +1,136 @@
+package go_data_structures
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DelayQueue holds items that each become available at a deadline. Take
+// blocks until the earliest deadline has passed; items with the same
+// deadline come out in the order they were added. It is safe for
+// concurrent use.
+type DelayQueue[T any] struct {
+	mu      sync.Mutex
+	queue   *PriorityQueue[int64, T] // deadlines as Unix nanoseconds, earliest first
+	clock   Clock
+	changed chan struct{} // closed and replaced whenever an item is added or removed
+}
+
+// NewDelayQueue creates an empty DelayQueue that reads time from clock, or
+// from SystemClock if clock is nil.
+func NewDelayQueue[T any](clock Clock) *DelayQueue[T] {
+	if clock == nil {
+		clock = SystemClock
+	}
+	return &DelayQueue[T]{queue: NewMinPriorityQueue[int64, T](), clock: clock, changed: make(chan struct{})}
+}
+
+func (dq *DelayQueue[T]) broadcast() {
+	close(dq.changed)
+	dq.changed = make(chan struct{})
+}
+
+// Add queues val to become available after delay, and returns a handle
+// that can cancel it. O(log n)
+func (dq *DelayQueue[T]) Add(val T, delay time.Duration) *PriorityQueueHandle[int64, T] {
+	return dq.AddAt(val, dq.clock.Now().Add(delay))
+}
+
+// AddAt queues val to become available at deadline. O(log n)
+func (dq *DelayQueue[T]) AddAt(val T, deadline time.Time) *PriorityQueueHandle[int64, T] {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	handle := dq.queue.Enqueue(deadline.UnixNano(), val)
+	dq.broadcast()
+	return handle
+}
+
+// Cancel removes handle's item, returning false if it was already taken or cancelled. O(log n)
+func (dq *DelayQueue[T]) Cancel(handle *PriorityQueueHandle[int64, T]) bool {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	if !dq.queue.Remove(handle) {
+		return false
+	}
+	dq.broadcast()
+	return true
+}
+
+// Poll removes and returns an item whose deadline has passed, and false if
+// there is none. O(log n)
+func (dq *DelayQueue[T]) Poll() (T, bool) {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	val, ok, _ := dq.pollLocked()
+	return val, ok
+}
+
+// pollLocked is Poll with mu held. When nothing is due it also returns how
+// long until the next deadline, or a negative duration if the queue is empty.
+func (dq *DelayQueue[T]) pollLocked() (T, bool, time.Duration) {
+	var zero T
+	front, ok := dq.queue.Front()
+	if !ok {
+		return zero, false, -1
+	}
+	if wait := time.Unix(0, front.Priority).Sub(dq.clock.Now()); wait > 0 {
+		return zero, false, wait
+	}
+	dq.queue.Dequeue()
+	dq.broadcast()
+	return front.Value, true, 0
+}
+
+// Take removes and returns the item with the earliest deadline once that
+// deadline has passed, blocking until then. It returns ctx's error if ctx is
+// done first.
+func (dq *DelayQueue[T]) Take(ctx context.Context) (T, error) {
+	dq.mu.Lock()
+	for {
+		val, ok, wait := dq.pollLocked()
+		if ok {
+			dq.mu.Unlock()
+			return val, nil
+		}
+		var due <-chan time.Time // nil, so never ready, while the queue is empty
+		if wait > 0 {
+			due = dq.clock.After(wait)
+		}
+		changed := dq.changed
+		dq.mu.Unlock()
+		select {
+		case <-due:
+		case <-changed:
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+		dq.mu.Lock()
+	}
+}
+
+// NextDeadline returns the earliest deadline, and false if the queue is empty.
+func (dq *DelayQueue[T]) NextDeadline() (time.Time, bool) {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	front, ok := dq.queue.Front()
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(0, front.Priority), true
+}
+
+// Empty returns whether the queue is empty.
+func (dq *DelayQueue[T]) Empty() bool {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	return dq.queue.Empty()
+}
+
+// Size returns the number of items in the queue, due or not.
+func (dq *DelayQueue[T]) Size() int {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	return dq.queue.Size()
+}
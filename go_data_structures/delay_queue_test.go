@@ -0,0 +1,139 @@
+package go_data_structures
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// waitForAfter blocks until fc has at least n pending After calls, so a
+// goroutine is known to be parked on the clock before the test advances it.
+func waitForAfter(t *testing.T, fc *FakeClock, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		fc.mu.Lock()
+		pending := len(fc.waiters)
+		fc.mu.Unlock()
+		if pending >= n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d pending After calls, want %d", pending, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestDelayQueueOrdering(t *testing.T) {
+	fc := NewFakeClock(time.Unix(0, 0))
+	dq := NewDelayQueue[string](fc)
+	dq.Add("c", 3*time.Second)
+	dq.Add("a", time.Second)
+	dq.Add("b1", 2*time.Second)
+	dq.Add("b2", 2*time.Second)
+	if _, ok := dq.Poll(); ok {
+		t.Fatalf("Poll returned an item before any deadline")
+	}
+	if d, ok := dq.NextDeadline(); !ok || !d.Equal(time.Unix(1, 0)) {
+		t.Fatalf("NextDeadline() = %v, %v, want 1s", d, ok)
+	}
+	fc.Advance(2 * time.Second)
+	for _, want := range []string{"a", "b1", "b2"} {
+		if got, ok := dq.Poll(); !ok || got != want {
+			t.Fatalf("Poll() = %q, %v, want %q", got, ok, want)
+		}
+	}
+	if _, ok := dq.Poll(); ok || dq.Size() != 1 {
+		t.Fatalf("Poll returned c before its deadline")
+	}
+	fc.Advance(time.Second)
+	if got, err := dq.Take(context.Background()); err != nil || got != "c" {
+		t.Fatalf("Take() = %q, %v, want c", got, err)
+	}
+	if !dq.Empty() {
+		t.Fatalf("queue not empty after taking everything")
+	}
+}
+
+func TestDelayQueueTakeBlocks(t *testing.T) {
+	fc := NewFakeClock(time.Unix(0, 0))
+	dq := NewDelayQueue[string](fc)
+	dq.Add("late", 10*time.Second)
+	got := make(chan string, 1)
+	go func() {
+		v, err := dq.Take(context.Background())
+		if err != nil {
+			t.Errorf("Take: %v", err)
+		}
+		got <- v
+	}()
+	waitForAfter(t, fc, 1)
+
+	// An earlier item added while Take waits must come out first.
+	dq.Add("early", time.Second)
+	waitForAfter(t, fc, 2)
+	fc.Advance(999 * time.Millisecond)
+	select {
+	case v := <-got:
+		t.Fatalf("Take returned %q before its deadline", v)
+	case <-time.After(20 * time.Millisecond):
+	}
+	fc.Advance(time.Millisecond)
+	if v := <-got; v != "early" {
+		t.Fatalf("Take() = %q, want early", v)
+	}
+}
+
+func TestDelayQueueCancel(t *testing.T) {
+	fc := NewFakeClock(time.Unix(0, 0))
+	dq := NewDelayQueue[int](fc)
+	h1 := dq.Add(1, time.Second)
+	dq.Add(2, 2*time.Second)
+	if !dq.Cancel(h1) || dq.Cancel(h1) {
+		t.Fatalf("Cancel should succeed exactly once")
+	}
+	if dq.Size() != 1 {
+		t.Fatalf("Size() = %d after Cancel, want 1", dq.Size())
+	}
+	fc.Advance(2 * time.Second)
+	if v, ok := dq.Poll(); !ok || v != 2 {
+		t.Fatalf("Poll() = %d, %v, want 2", v, ok)
+	}
+	h3 := dq.Add(3, 0)
+	if v, ok := dq.Poll(); !ok || v != 3 || dq.Cancel(h3) {
+		t.Fatalf("Cancel succeeded on a taken item")
+	}
+}
+
+func TestDelayQueueTakeContext(t *testing.T) {
+	fc := NewFakeClock(time.Unix(0, 0))
+	dq := NewDelayQueue[int](fc)
+	for _, pending := range []bool{false, true} {
+		if pending {
+			dq.Add(1, time.Hour)
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() {
+			_, err := dq.Take(ctx)
+			done <- err
+		}()
+		if pending {
+			waitForAfter(t, fc, 1)
+		}
+		cancel()
+		select {
+		case err := <-done:
+			if !errors.Is(err, context.Canceled) {
+				t.Fatalf("Take after cancel: %v, want context.Canceled", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Take still blocked after cancel")
+		}
+	}
+	if dq.Size() != 1 {
+		t.Fatalf("cancelled Take changed the queue: Size() = %d", dq.Size())
+	}
+}
@@ -0,0 +1,197 @@
+package go_data_structures
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+type DisjointSetInterface[T constraints.Ordered] interface {
+	Find(x T) (T, bool)    // returns the representative of x's set. O(α(n)) amortized
+	Union(a, b T) bool     // merges the sets of a and b, returns whether they were separate. O(α(n)) amortized
+	Connected(a, b T) bool // returns whether a and b are in the same set. O(α(n)) amortized
+	SetSize(x T) int       // returns the size of x's set. O(α(n)) amortized
+	Count() int            // returns number of disjoint sets. O(1)
+	Groups() [][]T         // returns the members of each set.
+	Size() int             // returns number of elements. O(1)
+}
+
+// DenseDisjointSet is a union-find over the integers 0..n-1, using path
+// halving and union by size. It is the fast path behind DisjointSet and can
+// be used directly when elements are already small integers.
+type DenseDisjointSet struct {
+	parent []int
+	size   []int // size[i] is only meaningful when i is a root
+	count  int
+}
+
+// NewDenseDisjointSet creates n singleton sets {0}, {1}, ..., {n-1}.
+func NewDenseDisjointSet(n int) *DenseDisjointSet {
+	ds := &DenseDisjointSet{}
+	for i := 0; i < n; i++ {
+		ds.Add()
+	}
+	return ds
+}
+
+// Add creates a new singleton set and returns its element.
+func (ds *DenseDisjointSet) Add() int {
+	x := len(ds.parent)
+	ds.parent = append(ds.parent, x)
+	ds.size = append(ds.size, 1)
+	ds.count++
+	return x
+}
+
+// Find returns the representative of x's set. x must be in 0..Size()-1.
+func (ds *DenseDisjointSet) Find(x int) int {
+	for ds.parent[x] != x {
+		// Path halving: point every other node on the path at its grandparent.
+		ds.parent[x] = ds.parent[ds.parent[x]]
+		x = ds.parent[x]
+	}
+	return x
+}
+
+// Union merges the sets of a and b, returning whether they were separate.
+func (ds *DenseDisjointSet) Union(a, b int) bool {
+	ra, rb := ds.Find(a), ds.Find(b)
+	if ra == rb {
+		return false
+	}
+	if ds.size[ra] < ds.size[rb] {
+		ra, rb = rb, ra
+	}
+	ds.parent[rb] = ra
+	ds.size[ra] += ds.size[rb]
+	ds.count--
+	return true
+}
+
+// Connected returns whether a and b are in the same set.
+func (ds *DenseDisjointSet) Connected(a, b int) bool {
+	return ds.Find(a) == ds.Find(b)
+}
+
+// SetSize returns the number of elements in x's set.
+func (ds *DenseDisjointSet) SetSize(x int) int {
+	return ds.size[ds.Find(x)]
+}
+
+// Count returns the number of disjoint sets.
+func (ds *DenseDisjointSet) Count() int {
+	return ds.count
+}
+
+// Size returns the number of elements.
+func (ds *DenseDisjointSet) Size() int {
+	return len(ds.parent)
+}
+
+// Groups returns the members of each set in ascending order. Groups are
+// ordered by their smallest member.
+func (ds *DenseDisjointSet) Groups() [][]int {
+	groups := make([][]int, 0, ds.count)
+	slot := make(map[int]int, ds.count) // root -> index in groups
+	for x := range ds.parent {
+		root := ds.Find(x)
+		i, ok := slot[root]
+		if !ok {
+			i = len(groups)
+			slot[root] = i
+			groups = append(groups, make([]int, 0, ds.size[root]))
+		}
+		groups[i] = append(groups[i], x)
+	}
+	return groups
+}
+
+// DisjointSet is a union-find over arbitrary ordered keys. Keys are mapped
+// to dense integer ids so the work is done by a DenseDisjointSet. Union adds
+// unseen keys as singletons.
+type DisjointSet[T constraints.Ordered] struct {
+	ids  map[T]int
+	keys []T // keys[id] is the key with that id
+	sets *DenseDisjointSet
+}
+
+var _ DisjointSetInterface[int] = (*DisjointSet[int])(nil)
+
+// NewDisjointSet creates an empty DisjointSet.
+func NewDisjointSet[T constraints.Ordered]() *DisjointSet[T] {
+	return &DisjointSet[T]{ids: make(map[T]int), sets: NewDenseDisjointSet(0)}
+}
+
+// Add inserts x as a singleton set if it is not already present.
+func (ds *DisjointSet[T]) Add(x T) {
+	ds.id(x)
+}
+
+// id returns x's dense id, adding x if needed.
+func (ds *DisjointSet[T]) id(x T) int {
+	if id, ok := ds.ids[x]; ok {
+		return id
+	}
+	id := ds.sets.Add()
+	ds.ids[x] = id
+	ds.keys = append(ds.keys, x)
+	return id
+}
+
+// Contains returns whether x has been added.
+func (ds *DisjointSet[T]) Contains(x T) bool {
+	_, ok := ds.ids[x]
+	return ok
+}
+
+// Find returns the representative of x's set, and false if x is unknown.
+func (ds *DisjointSet[T]) Find(x T) (T, bool) {
+	id, ok := ds.ids[x]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return ds.keys[ds.sets.Find(id)], true
+}
+
+// Union merges the sets of a and b, returning whether they were separate.
+func (ds *DisjointSet[T]) Union(a, b T) bool {
+	return ds.sets.Union(ds.id(a), ds.id(b))
+}
+
+// Connected returns whether a and b are known and in the same set.
+func (ds *DisjointSet[T]) Connected(a, b T) bool {
+	ia, okA := ds.ids[a]
+	ib, okB := ds.ids[b]
+	return okA && okB && ds.sets.Connected(ia, ib)
+}
+
+// SetSize returns the number of elements in x's set, or 0 if x is unknown.
+func (ds *DisjointSet[T]) SetSize(x T) int {
+	id, ok := ds.ids[x]
+	if !ok {
+		return 0
+	}
+	return ds.sets.SetSize(id)
+}
+
+// Count returns the number of disjoint sets.
+func (ds *DisjointSet[T]) Count() int {
+	return ds.sets.Count()
+}
+
+// Size returns the number of elements.
+func (ds *DisjointSet[T]) Size() int {
+	return len(ds.keys)
+}
+
+// Groups returns the members of each set in the order they were added.
+func (ds *DisjointSet[T]) Groups() [][]T {
+	dense := ds.sets.Groups()
+	groups := make([][]T, len(dense))
+	for i, ids := range dense {
+		groups[i] = make([]T, len(ids))
+		for j, id := range ids {
+			groups[i][j] = ds.keys[id]
+		}
+	}
+	return groups
+}
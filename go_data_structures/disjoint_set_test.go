@@ -0,0 +1,192 @@
+package go_data_structures
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// naiveDisjointSet labels every element with its set; Union relabels one side.
+type naiveDisjointSet struct {
+	label []int
+}
+
+func (m *naiveDisjointSet) add() int {
+	m.label = append(m.label, len(m.label))
+	return len(m.label) - 1
+}
+
+func (m *naiveDisjointSet) union(a, b int) bool {
+	la, lb := m.label[a], m.label[b]
+	if la == lb {
+		return false
+	}
+	for i, l := range m.label {
+		if l == lb {
+			m.label[i] = la
+		}
+	}
+	return true
+}
+
+func (m *naiveDisjointSet) setSize(x int) int {
+	n := 0
+	for _, l := range m.label {
+		if l == m.label[x] {
+			n++
+		}
+	}
+	return n
+}
+
+func (m *naiveDisjointSet) count() int {
+	labels := make(map[int]bool)
+	for _, l := range m.label {
+		labels[l] = true
+	}
+	return len(labels)
+}
+
+// groups returns the members of each set in ascending order, with groups
+// ordered by their smallest member.
+func (m *naiveDisjointSet) groups() [][]int {
+	byLabel := make(map[int][]int)
+	var order []int
+	for i, l := range m.label {
+		if byLabel[l] == nil {
+			order = append(order, l)
+		}
+		byLabel[l] = append(byLabel[l], i)
+	}
+	groups := make([][]int, 0, len(order))
+	for _, l := range order {
+		groups = append(groups, byLabel[l])
+	}
+	return groups
+}
+
+func TestDenseDisjointSetMatchesModel(t *testing.T) {
+	r := rand.New(rand.NewSource(19))
+	ds := NewDenseDisjointSet(50)
+	model := &naiveDisjointSet{}
+	for i := 0; i < 50; i++ {
+		model.add()
+	}
+	for op := 0; op < 400; op++ {
+		if r.Intn(10) == 0 {
+			if got, want := ds.Add(), model.add(); got != want {
+				t.Fatalf("Add() = %d, want %d", got, want)
+			}
+		}
+		a, b := r.Intn(ds.Size()), r.Intn(ds.Size())
+		if got, want := ds.Union(a, b), model.union(a, b); got != want {
+			t.Fatalf("Union(%d, %d) = %v, want %v", a, b, got, want)
+		}
+		x, y := r.Intn(ds.Size()), r.Intn(ds.Size())
+		if got, want := ds.Connected(x, y), model.label[x] == model.label[y]; got != want {
+			t.Fatalf("Connected(%d, %d) = %v, want %v", x, y, got, want)
+		}
+		if ds.Find(x) != ds.Find(ds.Find(x)) || (ds.Find(x) == ds.Find(y)) != (model.label[x] == model.label[y]) {
+			t.Fatalf("Find(%d) = %d and Find(%d) = %d disagree with the model", x, ds.Find(x), y, ds.Find(y))
+		}
+		if got, want := ds.SetSize(x), model.setSize(x); got != want {
+			t.Fatalf("SetSize(%d) = %d, want %d", x, got, want)
+		}
+		if ds.Count() != model.count() || ds.Size() != len(model.label) {
+			t.Fatalf("Count() = %d, Size() = %d, want %d, %d", ds.Count(), ds.Size(), model.count(), len(model.label))
+		}
+		if op%20 == 0 {
+			if got, want := ds.Groups(), model.groups(); !reflect.DeepEqual(got, want) {
+				t.Fatalf("Groups() = %v, want %v", got, want)
+			}
+		}
+	}
+}
+
+func TestDisjointSetMatchesModel(t *testing.T) {
+	r := rand.New(rand.NewSource(20))
+	ds := NewDisjointSet[string]()
+	model := &naiveDisjointSet{}
+	ids := make(map[string]int) // key to model element, in order of first use
+	var keys []string
+	id := func(k string) int {
+		if i, ok := ids[k]; ok {
+			return i
+		}
+		ids[k] = model.add()
+		keys = append(keys, k)
+		return ids[k]
+	}
+	key := func() string { return fmt.Sprintf("k%d", r.Intn(80)) }
+	for op := 0; op < 400; op++ {
+		if r.Intn(8) == 0 {
+			k := key()
+			ds.Add(k)
+			id(k)
+		}
+		a, b := key(), key()
+		// Union adds unseen keys, a first.
+		ia := id(a)
+		ib := id(b)
+		if got, want := ds.Union(a, b), model.union(ia, ib); got != want {
+			t.Fatalf("Union(%s, %s) = %v, want %v", a, b, got, want)
+		}
+		x, y := key(), key()
+		ix, knownX := ids[x]
+		iy, knownY := ids[y]
+		if got, want := ds.Contains(x), knownX; got != want {
+			t.Fatalf("Contains(%s) = %v, want %v", x, got, want)
+		}
+		if got, want := ds.Connected(x, y), knownX && knownY && model.label[ix] == model.label[iy]; got != want {
+			t.Fatalf("Connected(%s, %s) = %v, want %v", x, y, got, want)
+		}
+		want := 0
+		if knownX {
+			want = model.setSize(ix)
+		}
+		if got := ds.SetSize(x); got != want {
+			t.Fatalf("SetSize(%s) = %d, want %d", x, got, want)
+		}
+		if rep, ok := ds.Find(x); ok != knownX || (ok && model.label[ids[rep]] != model.label[ix]) {
+			t.Fatalf("Find(%s) = %s, %v", x, rep, ok)
+		}
+		if ds.Count() != model.count() || ds.Size() != len(keys) {
+			t.Fatalf("Count() = %d, Size() = %d, want %d, %d", ds.Count(), ds.Size(), model.count(), len(keys))
+		}
+		if op%20 == 0 {
+			var wantGroups [][]string
+			for _, g := range model.groups() {
+				names := make([]string, len(g))
+				for i, e := range g {
+					names[i] = keys[e]
+				}
+				wantGroups = append(wantGroups, names)
+			}
+			if got := ds.Groups(); !reflect.DeepEqual(got, wantGroups) {
+				t.Fatalf("Groups() = %v, want %v", got, wantGroups)
+			}
+		}
+	}
+}
+
+func TestDisjointSetUnknownKeys(t *testing.T) {
+	ds := NewDisjointSet[int]()
+	ds.Union(1, 2)
+	if _, ok := ds.Find(3); ok {
+		t.Fatal("Find found an unknown key")
+	}
+	if ds.Connected(1, 3) || ds.Connected(3, 3) {
+		t.Fatal("Connected reported an unknown key as connected")
+	}
+	if ds.SetSize(3) != 0 {
+		t.Fatalf("SetSize of an unknown key = %d, want 0", ds.SetSize(3))
+	}
+	// None of the queries may add the key.
+	if ds.Contains(3) || ds.Size() != 2 || ds.Count() != 1 {
+		t.Fatalf("queries changed the set: Size() = %d, Count() = %d", ds.Size(), ds.Count())
+	}
+	if groups := ds.Groups(); !reflect.DeepEqual(groups, [][]int{{1, 2}}) {
+		t.Fatalf("Groups() = %v", groups)
+	}
+}
@@ -0,0 +1,255 @@
+package go_data_structures
+
+// FibonacciHeapNode is one item in a FibonacciHeap; Insert returns it as a
+// handle for DecreaseKey and Delete.
+type FibonacciHeapNode[T any] struct {
+	value       T
+	parent      *FibonacciHeapNode[T]
+	child       *FibonacciHeapNode[T] // any one child; children form a circular list
+	left, right *FibonacciHeapNode[T] // circular list of siblings (or roots)
+	degree      int
+	mark        bool // lost a child since it last became a child itself
+	inHeap      bool
+}
+
+// Value returns the node's current value.
+func (n *FibonacciHeapNode[T]) Value() T {
+	return n.value
+}
+
+// FibonacciHeap is a meldable heap ordered by less. Insert, Peek, Meld and
+// DecreaseKey are amortized O(1); Pop and Delete are amortized O(log n).
+type FibonacciHeap[T any] struct {
+	top  *FibonacciHeapNode[T] // root that sorts first; nil if empty
+	size int
+	less func(a, b T) bool
+}
+
+var _ HeapInterface[int] = (*FibonacciHeap[int])(nil)
+
+// NewFibonacciHeap creates an empty Fibonacci heap ordered by less.
+func NewFibonacciHeap[T any](less func(a, b T) bool) *FibonacciHeap[T] {
+	return &FibonacciHeap[T]{less: less}
+}
+
+// splice joins the circular lists containing a and b.
+func splice[T any](a, b *FibonacciHeapNode[T]) {
+	aRight, bLeft := a.right, b.left
+	a.right, b.left = b, a
+	aRight.left, bLeft.right = bLeft, aRight
+}
+
+// unlink removes n from its circular list, leaving it a list of one.
+func unlink[T any](n *FibonacciHeapNode[T]) {
+	n.left.right, n.right.left = n.right, n.left
+	n.left, n.right = n, n
+}
+
+// addRoot puts a single node (or a whole circular list) into the root list.
+func (fh *FibonacciHeap[T]) addRoot(n *FibonacciHeapNode[T]) {
+	if fh.top == nil {
+		fh.top = n
+		return
+	}
+	splice(fh.top, n)
+	if fh.less(n.value, fh.top.value) {
+		fh.top = n
+	}
+}
+
+// Insert adds val and returns its node. O(1)
+func (fh *FibonacciHeap[T]) Insert(val T) *FibonacciHeapNode[T] {
+	node := &FibonacciHeapNode[T]{value: val, inHeap: true}
+	node.left, node.right = node, node
+	fh.addRoot(node)
+	fh.size++
+	return node
+}
+
+func (fh *FibonacciHeap[T]) Push(val T) {
+	fh.Insert(val)
+}
+
+func (fh *FibonacciHeap[T]) Peek() (T, bool) {
+	if fh.top == nil {
+		var zero T
+		return zero, false
+	}
+	return fh.top.value, true
+}
+
+// Pop removes and returns the top item. Amortized O(log n)
+func (fh *FibonacciHeap[T]) Pop() (T, bool) {
+	if fh.top == nil {
+		var zero T
+		return zero, false
+	}
+	top := fh.top
+	if child := top.child; child != nil {
+		for c := child; ; c = c.right {
+			c.parent = nil
+			if c.right == child {
+				break
+			}
+		}
+		splice(top, child)
+		top.child = nil
+	}
+	next := top.right
+	unlink(top)
+	fh.size--
+	top.inHeap = false
+	if next == top {
+		fh.top = nil
+	} else {
+		fh.top = next
+		fh.consolidate()
+	}
+	return top.value, true
+}
+
+// consolidate links roots of equal degree until every root has a distinct
+// degree, then points top at the root that sorts first.
+func (fh *FibonacciHeap[T]) consolidate() {
+	var roots []*FibonacciHeapNode[T]
+	for r := fh.top; ; {
+		roots = append(roots, r)
+		r = r.right
+		if r == fh.top {
+			break
+		}
+	}
+	var byDegree []*FibonacciHeapNode[T]
+	for _, r := range roots {
+		unlink(r)
+		for {
+			for len(byDegree) <= r.degree {
+				byDegree = append(byDegree, nil)
+			}
+			other := byDegree[r.degree]
+			if other == nil {
+				byDegree[r.degree] = r
+				break
+			}
+			byDegree[r.degree] = nil
+			if fh.less(other.value, r.value) {
+				r, other = other, r
+			}
+			// other becomes a child of r.
+			other.parent = r
+			other.mark = false
+			if r.child == nil {
+				r.child = other
+			} else {
+				splice(r.child, other)
+			}
+			r.degree++
+		}
+	}
+	fh.top = nil
+	for _, r := range byDegree {
+		if r != nil {
+			fh.addRoot(r)
+		}
+	}
+}
+
+// PushPop pushes val and then pops the top item.
+func (fh *FibonacciHeap[T]) PushPop(val T) T {
+	if fh.top == nil || !fh.less(fh.top.value, val) {
+		return val
+	}
+	top, _ := fh.Pop()
+	fh.Insert(val)
+	return top
+}
+
+// Replace pops the top item and then pushes val.
+func (fh *FibonacciHeap[T]) Replace(val T) (T, bool) {
+	top, ok := fh.Pop()
+	fh.Insert(val)
+	return top, ok
+}
+
+// DecreaseKey moves node toward the top by giving it val, which must not
+// sort after its current value. It returns false if the node is no longer
+// in the heap or val would move it down. node must belong to fh. Amortized O(1)
+func (fh *FibonacciHeap[T]) DecreaseKey(node *FibonacciHeapNode[T], val T) bool {
+	if node == nil || !node.inHeap || fh.less(node.value, val) {
+		return false
+	}
+	node.value = val
+	if node.parent != nil && fh.less(node.value, node.parent.value) {
+		fh.cascadingCut(node)
+	}
+	if fh.less(node.value, fh.top.value) {
+		fh.top = node
+	}
+	return true
+}
+
+// Delete removes node from the heap. It returns false if the node is no
+// longer in the heap. node must belong to fh. Amortized O(log n)
+func (fh *FibonacciHeap[T]) Delete(node *FibonacciHeapNode[T]) bool {
+	if node == nil || !node.inHeap {
+		return false
+	}
+	if node.parent != nil {
+		fh.cascadingCut(node)
+	}
+	// Pop node as though its key had been decreased past every other.
+	fh.top = node
+	fh.Pop()
+	return true
+}
+
+// cascadingCut moves n to the root list. A parent losing its second child
+// is cut as well, and so on up the tree.
+func (fh *FibonacciHeap[T]) cascadingCut(n *FibonacciHeapNode[T]) {
+	parent := n.parent
+	fh.cut(n)
+	for p := parent; p.parent != nil; {
+		if !p.mark {
+			p.mark = true
+			break
+		}
+		grandparent := p.parent
+		fh.cut(p)
+		p = grandparent
+	}
+}
+
+// cut moves n from its parent's child list to the root list.
+func (fh *FibonacciHeap[T]) cut(n *FibonacciHeapNode[T]) {
+	parent := n.parent
+	if parent.child == n {
+		if n.right == n {
+			parent.child = nil
+		} else {
+			parent.child = n.right
+		}
+	}
+	unlink(n)
+	parent.degree--
+	n.parent = nil
+	n.mark = false
+	fh.addRoot(n)
+}
+
+// Meld moves every item of other into fh in O(1), leaving other empty.
+// Nodes from other remain valid handles, now for fh.
+func (fh *FibonacciHeap[T]) Meld(other *FibonacciHeap[T]) {
+	if other.top != nil {
+		fh.addRoot(other.top)
+	}
+	fh.size += other.size
+	other.top, other.size = nil, 0
+}
+
+func (fh *FibonacciHeap[T]) Empty() bool {
+	return fh.top == nil
+}
+
+func (fh *FibonacciHeap[T]) Size() int {
+	return fh.size
+}
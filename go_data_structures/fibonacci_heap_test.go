@@ -0,0 +1,9 @@
+package go_data_structures
+
+import "testing"
+
+func TestFibonacciHeapHandles(t *testing.T) {
+	testMeldableHeap[*FibonacciHeapNode[int]](t, func() *FibonacciHeap[int] {
+		return NewFibonacciHeap(func(a, b int) bool { return a < b })
+	})
+}
@@ -0,0 +1,32 @@
+package go_data_structures
+
+import (
+	"encoding/json"
+	"hash/fnv"
+)
+
+// HashFunc maps a value to a 64-bit hash. Set, BloomFilter and CuckooFilter
+// accept one so callers can replace the default with something faster for
+// their key type.
+type HashFunc[T any] func(val T) uint64
+
+// DefaultHash hashes the JSON encoding of val with FNV-1a. It works for any
+// value but allocates; supply a specialized HashFunc on hot paths.
+func DefaultHash[T any](val T) uint64 {
+	h := fnv.New64a()
+	// Convert val to bytes. Note: This simplistic approach might need refinement for complex types T.
+	valBytes, _ := json.Marshal(val)
+	h.Write(valBytes)
+	return h.Sum64()
+}
+
+// mix64 is the splitmix64 finalizer. It spreads the bits of a hash so that
+// values derived from it (a second hash, a fingerprint) are independent enough.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
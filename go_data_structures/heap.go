@@ -4,97 +4,301 @@ import (
 	"golang.org/x/exp/constraints"
 )
 
-type MaxHeap[T constraints.Ordered] struct {
-	items []T
+type HeapInterface[T any] interface {
+	Push(val T)              // adds val to heap, increases size by 1. O(log n)
+	Pop() (T, bool)          // removes and returns the top item, returns false if empty. O(log n)
+	Peek() (T, bool)         // returns the top item without removing it, returns false if empty. O(1)
+	PushPop(val T) T         // pushes val then pops the top item, in one sift. O(log n)
+	Replace(val T) (T, bool) // pops the top item then pushes val, in one sift. O(log n)
+	Empty() bool             // returns whether heap is empty. O(1)
+	Size() int               // returns number of elements in heap. O(1)
 }
 
-func NewMaxHeap[T constraints.Ordered]() *MaxHeap[T] {
-	return &MaxHeap[T]{}
+// Heap is a d-ary heap ordered by less: the top is the item x for which
+// less(x, y) holds against every other y, so `<` gives a min-heap and `>` a max-heap.
+// It is binary unless built with NewDaryHeap; wider heaps are shallower and
+// keep a node's children in one cache line, which helps very large heaps.
+type Heap[T any] struct {
+	items    []T
+	less     func(a, b T) bool
+	arity    int                // children per node; 0 means 2
+	setIndex func(val T, i int) // optional; told every item's new position as it moves
 }
 
-func (h *MaxHeap[T]) Peek() T {
+var _ HeapInterface[int] = (*Heap[int])(nil)
+
+// NewHeap creates an empty heap ordered by less.
+func NewHeap[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{less: less}
+}
+
+// NewDaryHeap creates an empty heap ordered by less in which every node has
+// up to arity children. Push costs O(log_d n) comparisons and Pop O(d log_d n).
+func NewDaryHeap[T any](arity int, less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{less: less, arity: max(arity, 2)}
+}
+
+// NewMinHeap creates an empty heap that pops the smallest item first.
+func NewMinHeap[T constraints.Ordered]() *Heap[T] {
+	return NewHeap(func(a, b T) bool { return a < b })
+}
+
+// NewMaxHeap creates an empty heap that pops the largest item first.
+func NewMaxHeap[T constraints.Ordered]() *Heap[T] {
+	return NewHeap(func(a, b T) bool { return a > b })
+}
+
+// Heapify builds a binary heap ordered by less from items in O(n). The heap
+// takes ownership of items and reorders it in place.
+func Heapify[T any](items []T, less func(a, b T) bool) *Heap[T] {
+	h := &Heap[T]{items: items, less: less}
+	h.heapify()
+	return h
+}
+
+// HeapifyDary is Heapify for a heap in which every node has up to arity
+// children, like one from NewDaryHeap. O(n)
+func HeapifyDary[T any](arity int, items []T, less func(a, b T) bool) *Heap[T] {
+	h := &Heap[T]{items: items, less: less, arity: max(arity, 2)}
+	h.heapify()
+	return h
+}
+
+func (h *Heap[T]) heapify() {
+	if h.setIndex != nil {
+		for i, val := range h.items {
+			h.setIndex(val, i)
+		}
+	}
+	for i := h.parent(len(h.items) - 1); i >= 0; i-- {
+		h.siftDown(i)
+	}
+}
+
+func (h *Heap[T]) Push(val T) {
+	h.items = append(h.items, val)
+	h.place(len(h.items)-1, val)
+	h.siftUp(len(h.items) - 1)
+}
+
+func (h *Heap[T]) Pop() (T, bool) {
 	if len(h.items) == 0 {
 		var zero T
-		return zero
+		return zero, false
 	}
-	return h.items[0]
+	return h.removeAt(0), true
 }
 
-func (h *MaxHeap[T]) RemoveTop() {
+func (h *Heap[T]) Peek() (T, bool) {
 	if len(h.items) == 0 {
-		return
+		var zero T
+		return zero, false
 	}
-	h.items[0] = h.items[len(h.items)-1]
-	h.items = h.items[:len(h.items)-1]
-	h.siftDown(0)
+	return h.items[0], true
 }
 
-func (h *MaxHeap[T]) Insert(val T) {
-	h.items = append(h.items, val)
-	h.siftUp(len(h.items) - 1)
+// PushPop pushes val and then pops the top item. It is faster than Push
+// followed by Pop, and returns val itself if val would be the new top.
+func (h *Heap[T]) PushPop(val T) T {
+	if len(h.items) == 0 || !h.less(h.items[0], val) {
+		return val
+	}
+	top := h.items[0]
+	h.place(0, val)
+	h.siftDown(0)
+	return top
 }
 
-func (h *MaxHeap[T]) Remove(val T) {
-	for i, item := range h.items {
-		if item == val {
-			h.items[i] = h.items[len(h.items)-1]
-			h.items = h.items[:len(h.items)-1]
-			h.siftDown(i)
-			return
-		}
+// Replace pops the top item and then pushes val. It returns false, and just
+// pushes val, if the heap was empty.
+func (h *Heap[T]) Replace(val T) (T, bool) {
+	if len(h.items) == 0 {
+		h.Push(val)
+		var zero T
+		return zero, false
 	}
+	top := h.items[0]
+	h.place(0, val)
+	h.siftDown(0)
+	return top, true
 }
 
-func (h *MaxHeap[T]) Empty() bool {
+func (h *Heap[T]) Empty() bool {
 	return len(h.items) == 0
 }
 
-func (h *MaxHeap[T]) Size() int {
+func (h *Heap[T]) Size() int {
 	return len(h.items)
 }
 
-func (h *MaxHeap[T]) Sorted() []T {
-	originalItems := make([]T, len(h.items))
-	copy(originalItems, h.items)
+// removeAt removes and returns the item at index i. The last item takes its
+// place and is sifted whichever way restores the heap property.
+func (h *Heap[T]) removeAt(i int) T {
+	removed := h.items[i]
+	last := len(h.items) - 1
+	h.place(i, h.items[last])
+	var zero T
+	h.items[last] = zero // don't keep a reference to the removed item
+	h.items = h.items[:last]
+	if i < last {
+		h.fix(i)
+	}
+	return removed
+}
 
-	var sorted []T
-	for len(h.items) > 0 {
-		sorted = append(sorted, h.Peek())
-		h.RemoveTop()
+// place stores val at index i and reports its new position.
+func (h *Heap[T]) place(i int, val T) {
+	h.items[i] = val
+	if h.setIndex != nil {
+		h.setIndex(val, i)
 	}
+}
 
-	h.items = originalItems
-	return sorted
+func (h *Heap[T]) swap(i, j int) {
+	a, b := h.items[i], h.items[j]
+	h.place(i, b)
+	h.place(j, a)
+}
+
+func (h *Heap[T]) degree() int {
+	if h.arity == 0 {
+		return 2
+	}
+	return h.arity
 }
 
-func (h *MaxHeap[T]) siftUp(index int) {
+func (h *Heap[T]) parent(i int) int {
+	if i <= 0 {
+		return -1
+	}
+	return (i - 1) / h.degree()
+}
+
+// fix restores the heap property after the item at index i changed.
+func (h *Heap[T]) fix(i int) {
+	if i > 0 && h.less(h.items[i], h.items[h.parent(i)]) {
+		h.siftUp(i)
+	} else {
+		h.siftDown(i)
+	}
+}
+
+func (h *Heap[T]) siftUp(index int) {
 	for index > 0 {
-		parentIndex := (index - 1) / 2
-		if h.items[index] <= h.items[parentIndex] {
+		parentIndex := h.parent(index)
+		if !h.less(h.items[index], h.items[parentIndex]) {
 			break
 		}
-		h.items[index], h.items[parentIndex] = h.items[parentIndex], h.items[index]
+		h.swap(index, parentIndex)
 		index = parentIndex
 	}
 }
 
-func (h *MaxHeap[T]) siftDown(index int) {
+func (h *Heap[T]) siftDown(index int) {
+	d := h.degree()
 	lastIndex := len(h.items) - 1
 	for index < lastIndex {
-		leftChildIndex := 2*index + 1
-		rightChildIndex := 2*index + 2
-		largestIndex := index
+		firstChildIndex := d*index + 1
+		topIndex := index
 
-		if leftChildIndex <= lastIndex && h.items[leftChildIndex] > h.items[largestIndex] {
-			largestIndex = leftChildIndex
-		}
-		if rightChildIndex <= lastIndex && h.items[rightChildIndex] > h.items[largestIndex] {
-			largestIndex = rightChildIndex
+		for childIndex := firstChildIndex; childIndex < firstChildIndex+d && childIndex <= lastIndex; childIndex++ {
+			if h.less(h.items[childIndex], h.items[topIndex]) {
+				topIndex = childIndex
+			}
 		}
-		if largestIndex == index {
+		if topIndex == index {
 			break
 		}
-		h.items[index], h.items[largestIndex] = h.items[largestIndex], h.items[index]
-		index = largestIndex
+		h.swap(index, topIndex)
+		index = topIndex
 	}
 }
+
+// MaxHeap is a max-heap of ordered values with an Insert/RemoveTop API,
+// kept as a thin wrapper over Heap.
+type MaxHeap[T constraints.Ordered] struct {
+	heap *Heap[T]
+}
+
+// NewBinaryMaxHeap creates an empty MaxHeap.
+func NewBinaryMaxHeap[T constraints.Ordered]() *MaxHeap[T] {
+	return &MaxHeap[T]{heap: NewMaxHeap[T]()}
+}
+
+// NewDaryMaxHeap creates an empty MaxHeap in which every node has up to arity children.
+func NewDaryMaxHeap[T constraints.Ordered](arity int) *MaxHeap[T] {
+	return &MaxHeap[T]{heap: NewDaryHeap(arity, func(a, b T) bool { return a > b })}
+}
+
+func (h *MaxHeap[T]) Peek() T {
+	top, _ := h.heap.Peek()
+	return top
+}
+
+func (h *MaxHeap[T]) RemoveTop() {
+	h.heap.Pop()
+}
+
+func (h *MaxHeap[T]) Insert(val T) {
+	h.heap.Push(val)
+}
+
+func (h *MaxHeap[T]) Remove(val T) {
+	for i, item := range h.heap.items {
+		if item == val {
+			h.heap.removeAt(i)
+			return
+		}
+	}
+}
+
+func (h *MaxHeap[T]) Empty() bool {
+	return h.heap.Empty()
+}
+
+func (h *MaxHeap[T]) Size() int {
+	return h.heap.Size()
+}
+
+// Sorted returns the items from largest to smallest, leaving the heap untouched.
+func (h *MaxHeap[T]) Sorted() []T {
+	sorted := make([]T, len(h.heap.items))
+	copy(sorted, h.heap.items)
+	HeapSort(sorted, h.heap.less)
+	return sorted
+}
+
+// HeapSort sorts s in place so that less never holds for a later item
+// against an earlier one. It is not stable. O(n log n)
+func HeapSort[T any](s []T, less func(a, b T) bool) {
+	h := heapPrefix(s, less)
+	for end := len(s) - 1; end > 0; end-- {
+		s[0], s[end] = s[end], s[0]
+		h.items = s[:end]
+		h.siftDown(0)
+	}
+}
+
+// SelectTopK rearranges s so that s[:k] holds its k largest items under
+// less, largest first, and returns s[:k]. The rest of s is left in no
+// particular order. O(n + k log n)
+func SelectTopK[T any](s []T, k int, less func(a, b T) bool) []T {
+	k = min(max(k, 0), len(s))
+	h := heapPrefix(s, less)
+	// Pop the k largest to the back of s, largest last, then reverse s to
+	// bring them to the front in order.
+	for end := len(s) - 1; end >= len(s)-k && end > 0; end-- {
+		s[0], s[end] = s[end], s[0]
+		h.items = s[:end]
+		h.siftDown(0)
+	}
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+	return s[:k]
+}
+
+// heapPrefix heapifies s in place with the largest item under less on top.
+func heapPrefix[T any](s []T, less func(a, b T) bool) *Heap[T] {
+	return Heapify(s, func(a, b T) bool { return less(b, a) })
+}
@@ -0,0 +1,357 @@
+package go_data_structures
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+type benchEdge struct {
+	to, weight int
+}
+
+// vertexDist is a Dijkstra queue entry: a vertex and its tentative distance.
+type vertexDist struct {
+	vertex, dist int
+}
+
+func lessDist(a, b vertexDist) bool {
+	return a.dist < b.dist
+}
+
+// benchGraph returns a random directed graph with n vertices and degree
+// out-edges each, plus a ring so every vertex is reachable from 0.
+func benchGraph(n, degree int) [][]benchEdge {
+	r := rand.New(rand.NewSource(1))
+	adj := make([][]benchEdge, n)
+	for v := range adj {
+		adj[v] = append(adj[v], benchEdge{(v + 1) % n, 1000})
+		for i := 1; i < degree; i++ {
+			adj[v] = append(adj[v], benchEdge{r.Intn(n), 1 + r.Intn(1000)})
+		}
+	}
+	return adj
+}
+
+// dijkstraLazy runs Dijkstra from vertex 0 on a heap without decrease-key,
+// pushing a fresh entry on every improvement and skipping stale ones on Pop.
+// It returns the sum of all distances.
+func dijkstraLazy(adj [][]benchEdge, h HeapInterface[vertexDist]) int {
+	dist := make([]int, len(adj))
+	for i := range dist {
+		dist[i] = -1
+	}
+	dist[0] = 0
+	h.Push(vertexDist{0, 0})
+	sum := 0
+	for {
+		top, ok := h.Pop()
+		if !ok {
+			return sum
+		}
+		if top.dist > dist[top.vertex] {
+			continue
+		}
+		sum += top.dist
+		for _, e := range adj[top.vertex] {
+			if d := top.dist + e.weight; dist[e.to] < 0 || d < dist[e.to] {
+				dist[e.to] = d
+				h.Push(vertexDist{e.to, d})
+			}
+		}
+	}
+}
+
+// dijkstraDecreaseKey runs Dijkstra from vertex 0, keeping one queue entry
+// per vertex and lowering it in place. insert, pop and decrease wrap the
+// heap under test. It returns the sum of all distances.
+func dijkstraDecreaseKey[N any](adj [][]benchEdge, insert func(vertexDist) N, pop func() (vertexDist, bool), decrease func(N, vertexDist)) int {
+	dist := make([]int, len(adj))
+	nodes := make([]N, len(adj))
+	queued := make([]bool, len(adj))
+	for i := range dist {
+		dist[i] = -1
+	}
+	dist[0] = 0
+	nodes[0], queued[0] = insert(vertexDist{0, 0}), true
+	sum := 0
+	for {
+		top, ok := pop()
+		if !ok {
+			return sum
+		}
+		queued[top.vertex] = false
+		sum += top.dist
+		for _, e := range adj[top.vertex] {
+			d := top.dist + e.weight
+			switch {
+			case dist[e.to] < 0:
+				dist[e.to] = d
+				nodes[e.to], queued[e.to] = insert(vertexDist{e.to, d}), true
+			case d < dist[e.to] && queued[e.to]:
+				dist[e.to] = d
+				decrease(nodes[e.to], vertexDist{e.to, d})
+			}
+		}
+	}
+}
+
+// BenchmarkDijkstra compares the heaps on a mixed Push/DecreaseKey/Pop
+// workload: single-source shortest paths over a random sparse graph.
+func BenchmarkDijkstra(b *testing.B) {
+	adj := benchGraph(20000, 8)
+	want := dijkstraLazy(adj, NewHeap(lessDist))
+	check := func(b *testing.B, got int) {
+		if got != want {
+			b.Fatalf("distance sum %d, want %d", got, want)
+		}
+	}
+	b.Run("Heap", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			check(b, dijkstraLazy(adj, NewHeap(lessDist)))
+		}
+	})
+	b.Run("DaryHeap4", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			check(b, dijkstraLazy(adj, NewDaryHeap(4, lessDist)))
+		}
+	})
+	b.Run("IndexedHeap", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			h := NewIndexedHeap(lessDist)
+			check(b, dijkstraDecreaseKey(adj, h.Push, h.Pop, func(n *HeapHandle[vertexDist], v vertexDist) {
+				h.Update(n, v)
+			}))
+		}
+	})
+	b.Run("PairingHeap", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			h := NewPairingHeap(lessDist)
+			check(b, dijkstraDecreaseKey(adj, h.Insert, h.Pop, func(n *PairingHeapNode[vertexDist], v vertexDist) {
+				h.DecreaseKey(n, v)
+			}))
+		}
+	})
+	b.Run("FibonacciHeap", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			h := NewFibonacciHeap(lessDist)
+			check(b, dijkstraDecreaseKey(adj, h.Insert, h.Pop, func(n *FibonacciHeapNode[vertexDist], v vertexDist) {
+				h.DecreaseKey(n, v)
+			}))
+		}
+	})
+}
+
+func TestHeapMatchesSortedSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(21))
+	for _, maxFirst := range []bool{false, true} {
+		h := NewMinHeap[int]()
+		if maxFirst {
+			h = NewMaxHeap[int]()
+		}
+		var model []int // sorted so that model[0] is the top
+		top := func() int { return model[0] }
+		insert := func(v int) {
+			i := sort.Search(len(model), func(i int) bool { return h.less(v, model[i]) })
+			model = append(model[:i], append([]int{v}, model[i:]...)...)
+		}
+		for op := 0; op < 3000; op++ {
+			v := r.Intn(500)
+			switch r.Intn(4) {
+			case 0, 1:
+				h.Push(v)
+				insert(v)
+			case 2:
+				got, ok := h.Pop()
+				if ok != (len(model) > 0) || (ok && got != top()) {
+					t.Fatalf("maxFirst=%v: Pop() = %d, %v", maxFirst, got, ok)
+				}
+				if ok {
+					model = model[1:]
+				}
+			case 3:
+				got := h.PushPop(v)
+				insert(v)
+				if got != top() {
+					t.Fatalf("maxFirst=%v: PushPop(%d) = %d, want %d", maxFirst, v, got, top())
+				}
+				model = model[1:]
+			}
+			if peek, ok := h.Peek(); ok != (len(model) > 0) || (ok && peek != top()) || h.Size() != len(model) {
+				t.Fatalf("maxFirst=%v: Peek() = %d, %v, Size() = %d, model holds %d", maxFirst, peek, ok, h.Size(), len(model))
+			}
+		}
+	}
+}
+
+func TestHeapPushPopAndReplace(t *testing.T) {
+	h := NewMinHeap[int]()
+	// PushPop on an empty heap hands val straight back.
+	if got := h.PushPop(5); got != 5 || !h.Empty() {
+		t.Fatalf("PushPop(5) on an empty heap = %d, Size() = %d", got, h.Size())
+	}
+	// Replace on an empty heap just pushes.
+	if got, ok := h.Replace(5); ok || got != 0 || h.Size() != 1 {
+		t.Fatalf("Replace(5) on an empty heap = %d, %v, Size() = %d", got, ok, h.Size())
+	}
+	h.Push(8)
+	// An item that would be the new top comes back without touching the heap.
+	if got := h.PushPop(3); got != 3 || h.Size() != 2 {
+		t.Fatalf("PushPop(3) below the root = %d, Size() = %d", got, h.Size())
+	}
+	if got := h.PushPop(5); got != 5 {
+		t.Fatalf("PushPop(5) equal to the root = %d", got)
+	}
+	if got := h.PushPop(9); got != 5 {
+		t.Fatalf("PushPop(9) = %d, want the old root 5", got)
+	}
+	// Replace always returns the old top, even when val is smaller.
+	if got, ok := h.Replace(1); !ok || got != 8 {
+		t.Fatalf("Replace(1) = %d, %v, want 8", got, ok)
+	}
+	for _, want := range []int{1, 9} {
+		if got, ok := h.Pop(); !ok || got != want {
+			t.Fatalf("Pop() = %d, %v, want %d", got, ok, want)
+		}
+	}
+	if _, ok := h.Pop(); ok {
+		t.Fatal("Pop() on an empty heap succeeded")
+	}
+}
+
+func TestHeapifyMatchesSort(t *testing.T) {
+	r := rand.New(rand.NewSource(22))
+	for _, n := range []int{0, 1, 2, 3, 10, 100, 1000} {
+		items := make([]int, n)
+		for i := range items {
+			items[i] = r.Intn(50) // plenty of duplicates
+		}
+		want := append([]int(nil), items...)
+		sort.Ints(want)
+		h := Heapify(items, func(a, b int) bool { return a < b })
+		if h.Size() != n {
+			t.Fatalf("n %d: Size() = %d", n, h.Size())
+		}
+		for i, w := range want {
+			if got, ok := h.Pop(); !ok || got != w {
+				t.Fatalf("n %d: Pop() #%d = %d, %v, want %d", n, i, got, ok, w)
+			}
+		}
+	}
+}
+
+func TestMaxHeapWrapper(t *testing.T) {
+	for _, h := range []*MaxHeap[int]{NewBinaryMaxHeap[int](), NewDaryMaxHeap[int](4)} {
+		for _, v := range []int{4, 9, 1, 7, 9, 3} {
+			h.Insert(v)
+		}
+		h.Remove(7)
+		h.Remove(100) // not present
+		var got []int
+		for !h.Empty() {
+			got = append(got, h.Peek())
+			h.RemoveTop()
+		}
+		if !reflect.DeepEqual(got, []int{9, 9, 4, 3, 1}) {
+			t.Fatalf("drained %v", got)
+		}
+		if h.Peek() != 0 || h.Size() != 0 {
+			t.Fatalf("empty heap: Peek() = %d, Size() = %d", h.Peek(), h.Size())
+		}
+	}
+}
+
+func TestHeapifyDary(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for _, arity := range []int{2, 3, 4, 8} {
+		for _, n := range []int{0, 1, 2, 9, 100, 1000} {
+			items := r.Perm(n)
+			h := HeapifyDary(arity, items, func(a, b int) bool { return a < b })
+			for i := 1; i < n; i++ {
+				if p := (i - 1) / arity; items[i] < items[p] {
+					t.Fatalf("arity %d, n %d: items[%d] = %d below its parent %d", arity, n, i, items[i], items[p])
+				}
+			}
+			for want := 0; want < n; want++ {
+				if got, ok := h.Pop(); !ok || got != want {
+					t.Fatalf("arity %d, n %d: Pop() = %d, %v, want %d", arity, n, got, ok, want)
+				}
+			}
+		}
+	}
+}
+
+// benchmarkHeapArity measures pushing n random items onto an empty heap of
+// the given arity, and popping n items from a full one, separately.
+func benchmarkHeapArity(b *testing.B, arity, n int) {
+	vals := rand.New(rand.NewSource(2)).Perm(n)
+	less := func(a, b int) bool { return a < b }
+	b.Run("push", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			h := NewDaryHeap(arity, less)
+			for _, v := range vals {
+				h.Push(v)
+			}
+		}
+	})
+	b.Run("pop", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			h := HeapifyDary(arity, append([]int(nil), vals...), less)
+			b.StartTimer()
+			for !h.Empty() {
+				h.Pop()
+			}
+		}
+	})
+}
+
+func BenchmarkHeapArity2(b *testing.B) { benchmarkHeapArity(b, 2, 100000) }
+func BenchmarkHeapArity4(b *testing.B) { benchmarkHeapArity(b, 4, 100000) }
+func BenchmarkHeapArity8(b *testing.B) { benchmarkHeapArity(b, 8, 100000) }
+
+func TestHeapSortAndSelectTopK(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	r := rand.New(rand.NewSource(6))
+	for it := 0; it < 200; it++ {
+		n, k := r.Intn(300), r.Intn(40)
+		s := make([]int, n)
+		for i := range s {
+			s[i] = r.Intn(100) // small range, so ties are common
+		}
+
+		got := SelectTopK(append([]int(nil), s...), k, less)
+		if want := largestFirst(s, k); len(got) != len(want) || (len(want) > 0 && !reflect.DeepEqual(got, want)) {
+			t.Fatalf("SelectTopK(%d) = %v, want %v", k, got, want)
+		}
+
+		sorted, ref := append([]int(nil), s...), append([]int(nil), s...)
+		HeapSort(sorted, less)
+		sort.Ints(ref)
+		if !reflect.DeepEqual(sorted, ref) {
+			t.Fatalf("HeapSort(%v) = %v", s, sorted)
+		}
+	}
+}
+
+func TestMaxHeapSortedLeavesHeapUnchanged(t *testing.T) {
+	h := NewDaryMaxHeap[int](3)
+	vals := rand.New(rand.NewSource(24)).Perm(50)
+	for _, v := range vals {
+		h.Insert(v)
+	}
+	before := append([]int(nil), h.heap.items...)
+	sorted := h.Sorted()
+	if !reflect.DeepEqual(sorted, largestFirst(vals, len(vals))) {
+		t.Fatalf("Sorted() = %v", sorted)
+	}
+	if h.Size() != len(vals) || h.Peek() != 49 || !reflect.DeepEqual(h.heap.items, before) {
+		t.Fatalf("Sorted() changed the heap: Size() = %d, Peek() = %d", h.Size(), h.Peek())
+	}
+	// The result is a copy the heap does not share.
+	sorted[0] = -1
+	if h.Peek() != 49 {
+		t.Fatal("writing to the Sorted() result changed the heap")
+	}
+}
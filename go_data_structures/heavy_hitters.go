@@ -0,0 +1,100 @@
+package go_data_structures
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+// HeavyHitters tracks the k most frequent values of a stream. A conservative
+// CountMinSketch estimates every value's count, and a min-priority queue of
+// the current top k decides which candidate to evict. Reported counts carry
+// the sketch's error bound.
+type HeavyHitters[T constraints.Ordered] struct {
+	k      int
+	sketch *CountMinSketch[T]
+	counts map[T]uint64 // current top-k candidates and their estimates
+	// queue orders candidates by estimate, smallest first. Entries go stale
+	// when a candidate's estimate rises or it is evicted; they are skipped
+	// when they reach the front.
+	queue *PriorityQueue[uint64, T]
+}
+
+// NewHeavyHitters creates a tracker for the k most frequent values, with
+// per-value counts within epsilon*Total() with probability 1-delta.
+func NewHeavyHitters[T constraints.Ordered](k int, epsilon, delta float64) *HeavyHitters[T] {
+	return &HeavyHitters[T]{
+		k:      k,
+		sketch: NewCountMinSketch[T](epsilon, delta, true),
+		counts: make(map[T]uint64),
+		queue:  NewMinPriorityQueue[uint64, T](),
+	}
+}
+
+// Add records one occurrence of val. O(depth + log k) amortized
+func (hh *HeavyHitters[T]) Add(val T) {
+	hh.sketch.Add(val, 1)
+	if hh.k <= 0 {
+		return
+	}
+	estimate := hh.sketch.Estimate(val)
+	if _, tracked := hh.counts[val]; !tracked && len(hh.counts) >= hh.k {
+		weakest, count := hh.weakest()
+		if estimate <= count {
+			return
+		}
+		delete(hh.counts, weakest)
+		hh.queue.Dequeue()
+	}
+	hh.counts[val] = estimate
+	hh.queue.Enqueue(estimate, val)
+	// Keep stale entries from growing the queue without bound.
+	if hh.queue.Size() > 2*hh.k+16 {
+		hh.rebuild()
+	}
+}
+
+// weakest drops stale entries from the front of the queue and returns the
+// tracked candidate with the lowest estimate.
+func (hh *HeavyHitters[T]) weakest() (T, uint64) {
+	for {
+		front, _ := hh.queue.Front()
+		if count, ok := hh.counts[front.Value]; ok && count == front.Priority {
+			return front.Value, count
+		}
+		hh.queue.Dequeue()
+	}
+}
+
+func (hh *HeavyHitters[T]) rebuild() {
+	hh.queue = NewMinPriorityQueue[uint64, T]()
+	for val, count := range hh.counts {
+		hh.queue.Enqueue(count, val)
+	}
+}
+
+// Estimate returns the sketch's estimate for val, tracked or not.
+func (hh *HeavyHitters[T]) Estimate(val T) uint64 {
+	return hh.sketch.Estimate(val)
+}
+
+// Top returns the tracked values with their estimated counts, most frequent
+// first. Ties are broken by ascending value so results are stable.
+func (hh *HeavyHitters[T]) Top() []Pair[T, uint64] {
+	top := make([]Pair[T, uint64], 0, len(hh.counts))
+	for val, count := range hh.counts {
+		top = append(top, Pair[T, uint64]{Key: val, Value: count})
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Value != top[j].Value {
+			return top[i].Value > top[j].Value
+		}
+		return top[i].Key < top[j].Key
+	})
+	return top
+}
+
+// Total returns the number of values added.
+func (hh *HeavyHitters[T]) Total() uint64 {
+	return hh.sketch.Total()
+}
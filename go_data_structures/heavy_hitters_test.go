@@ -0,0 +1,66 @@
+package go_data_structures
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestHeavyHittersZipf(t *testing.T) {
+	const (
+		n       = 100000
+		k       = 20
+		epsilon = 0.001
+		delta   = 0.01
+	)
+	zipf := rand.NewZipf(rand.New(rand.NewSource(18)), 1.1, 1, 5000)
+	hh := NewHeavyHitters[int](k, epsilon, delta)
+	truth := make(map[int]uint64)
+	for i := 0; i < n; i++ {
+		v := int(zipf.Uint64())
+		hh.Add(v)
+		truth[v]++
+	}
+	if hh.Total() != n {
+		t.Fatalf("Total() = %d, want %d", hh.Total(), n)
+	}
+
+	top := hh.Top()
+	if len(top) != k {
+		t.Fatalf("Top() has %d values, want %d", len(top), k)
+	}
+	// Estimates never undercount, and overcount by at most epsilon*N.
+	slack := uint64(epsilon * n)
+	reported := make(map[int]bool, k)
+	for i, p := range top {
+		reported[p.Key] = true
+		if p.Value < truth[p.Key] || p.Value > truth[p.Key]+slack {
+			t.Errorf("count of %d is %d, true count %d, allowed error %d", p.Key, p.Value, truth[p.Key], slack)
+		}
+		if i > 0 && p.Value > top[i-1].Value {
+			t.Fatalf("Top() is not in descending order at %d", i)
+		}
+	}
+
+	// A value whose true count beats the (k+1)th largest by more than the
+	// error bound outranks every value outside the true top k, so it must
+	// be reported.
+	counts := make([]uint64, 0, len(truth))
+	for _, c := range truth {
+		counts = append(counts, c)
+	}
+	sort.Slice(counts, func(i, j int) bool { return counts[i] > counts[j] })
+	threshold := counts[k] + slack
+	heavy := 0
+	for v, c := range truth {
+		if c > threshold {
+			heavy++
+			if !reported[v] {
+				t.Errorf("%d with true count %d > %d was not reported", v, c, threshold)
+			}
+		}
+	}
+	if heavy < k/2 {
+		t.Fatalf("only %d values above the threshold; the stream is too flat to test", heavy)
+	}
+}
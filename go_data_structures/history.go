@@ -0,0 +1,149 @@
+package go_data_structures
+
+// Command is an undoable action recorded by a History. Redo calls Do again.
+type Command interface {
+	Do()
+	Undo()
+}
+
+// History records commands for undo and redo. Each entry is a group of
+// commands undone and redone together: a single Do outside a transaction,
+// or everything done between Begin and the matching Commit.
+type History[C Command] struct {
+	undo     *RingDeque[[]C] // newest at the back; a deque so eviction of the oldest is O(1)
+	redo     *AlternateStack[[]C]
+	open     *AlternateStack[[]C] // transactions in progress, innermost on top
+	maxDepth int                  // most entries kept for undo; 0 means no limit
+}
+
+// NewHistory creates an empty History that keeps at most maxDepth entries
+// for undo, forgetting the oldest first, or any number if maxDepth is 0.
+func NewHistory[C Command](maxDepth int) *History[C] {
+	return &History[C]{
+		undo:     NewRingDeque[[]C](),
+		redo:     NewAlternateStack[[]C](),
+		open:     NewAlternateStack[[]C](),
+		maxDepth: max(maxDepth, 0),
+	}
+}
+
+// Do runs cmd and records it, discarding anything that could be redone.
+func (h *History[C]) Do(cmd C) {
+	cmd.Do()
+	if group, ok := h.open.Pop(); ok {
+		h.open.Push(append(group, cmd))
+		return
+	}
+	h.record([]C{cmd})
+}
+
+func (h *History[C]) record(group []C) {
+	h.undo.PushBack(group)
+	h.redo.Clear()
+	if h.maxDepth > 0 && h.undo.Size() > h.maxDepth {
+		h.undo.PopFront()
+	}
+}
+
+// Undo reverts the latest entry, returning false if there is none or a
+// transaction is in progress.
+func (h *History[C]) Undo() bool {
+	if !h.open.Empty() {
+		return false
+	}
+	group, ok := h.undo.PopBack()
+	if !ok {
+		return false
+	}
+	undoGroup(group)
+	h.redo.Push(group)
+	return true
+}
+
+// Redo re-applies the latest undone entry, returning false if there is none
+// or a transaction is in progress.
+func (h *History[C]) Redo() bool {
+	if !h.open.Empty() {
+		return false
+	}
+	group, ok := h.redo.Pop()
+	if !ok {
+		return false
+	}
+	for _, cmd := range group {
+		cmd.Do()
+	}
+	h.undo.PushBack(group)
+	return true
+}
+
+func undoGroup[C Command](group []C) {
+	for i := len(group) - 1; i >= 0; i-- {
+		group[i].Undo()
+	}
+}
+
+// Begin starts a transaction. Transactions nest; an inner one folds into
+// its parent when committed.
+func (h *History[C]) Begin() {
+	h.open.Push(nil)
+}
+
+// Commit ends the innermost transaction, recording its commands as one
+// entry, and returns false if no transaction is in progress. An empty
+// transaction records nothing.
+func (h *History[C]) Commit() bool {
+	group, ok := h.open.Pop()
+	if !ok {
+		return false
+	}
+	if parent, ok := h.open.Pop(); ok {
+		h.open.Push(append(parent, group...))
+	} else if len(group) > 0 {
+		h.record(group)
+	}
+	return true
+}
+
+// Rollback ends the innermost transaction by undoing its commands, and
+// returns false if no transaction is in progress.
+func (h *History[C]) Rollback() bool {
+	group, ok := h.open.Pop()
+	if !ok {
+		return false
+	}
+	undoGroup(group)
+	return true
+}
+
+// InTransaction returns whether a transaction is in progress.
+func (h *History[C]) InTransaction() bool {
+	return !h.open.Empty()
+}
+
+// CanUndo returns whether Undo would do anything.
+func (h *History[C]) CanUndo() bool {
+	return h.open.Empty() && !h.undo.Empty()
+}
+
+// CanRedo returns whether Redo would do anything.
+func (h *History[C]) CanRedo() bool {
+	return h.open.Empty() && !h.redo.Empty()
+}
+
+// UndoDepth returns the number of entries that can be undone.
+func (h *History[C]) UndoDepth() int {
+	return h.undo.Size()
+}
+
+// RedoDepth returns the number of entries that can be redone.
+func (h *History[C]) RedoDepth() int {
+	return h.redo.Size()
+}
+
+// Clear forgets every entry without undoing anything. Transactions in
+// progress are kept.
+func (h *History[C]) Clear() {
+	h.undo.Clear()
+	h.redo.Clear()
+}
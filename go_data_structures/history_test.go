@@ -0,0 +1,85 @@
+package go_data_structures
+
+import (
+	"reflect"
+	"testing"
+)
+
+// appendCmd appends x to *vals and removes it again on Undo.
+type appendCmd struct {
+	vals *[]int
+	x    int
+}
+
+func (c appendCmd) Do()   { *c.vals = append(*c.vals, c.x) }
+func (c appendCmd) Undo() { *c.vals = (*c.vals)[:len(*c.vals)-1] }
+
+func TestHistoryBoundedDepth(t *testing.T) {
+	var vals []int
+	h := NewHistory[appendCmd](3)
+	for i := 1; i <= 5; i++ {
+		h.Do(appendCmd{&vals, i})
+	}
+	if h.UndoDepth() != 3 {
+		t.Fatalf("UndoDepth() = %d, want 3", h.UndoDepth())
+	}
+	for h.Undo() {
+	}
+	if !reflect.DeepEqual(vals, []int{1, 2}) {
+		t.Fatalf("after undoing everything: %v, want [1 2]", vals)
+	}
+	if !h.Redo() || h.RedoDepth() != 2 || !reflect.DeepEqual(vals, []int{1, 2, 3}) {
+		t.Fatalf("after Redo: %v, RedoDepth %d", vals, h.RedoDepth())
+	}
+	h.Do(appendCmd{&vals, 9})
+	if h.CanRedo() || h.UndoDepth() != 2 {
+		t.Fatalf("Do kept redo entries or lost undo ones")
+	}
+}
+
+func TestHistoryTransactions(t *testing.T) {
+	var vals []int
+	h := NewHistory[appendCmd](0)
+	h.Do(appendCmd{&vals, 1})
+	h.Begin()
+	h.Do(appendCmd{&vals, 2})
+	h.Begin()
+	h.Do(appendCmd{&vals, 3})
+	h.Commit()
+	if h.Undo() || h.CanUndo() {
+		t.Fatalf("Undo succeeded inside a transaction")
+	}
+	h.Commit()
+	if h.UndoDepth() != 2 {
+		t.Fatalf("UndoDepth() = %d after nested commit, want 2", h.UndoDepth())
+	}
+	h.Undo()
+	if !reflect.DeepEqual(vals, []int{1}) {
+		t.Fatalf("undoing the transaction left %v, want [1]", vals)
+	}
+	h.Redo()
+	h.Begin()
+	h.Do(appendCmd{&vals, 4})
+	h.Rollback()
+	if h.InTransaction() || !reflect.DeepEqual(vals, []int{1, 2, 3}) {
+		t.Fatalf("after Rollback: %v, want [1 2 3]", vals)
+	}
+	if h.Commit() || h.Rollback() {
+		t.Fatalf("Commit or Rollback succeeded with no transaction")
+	}
+}
+
+// BenchmarkHistoryBoundedDo measures Do once the history is at its limit,
+// where every entry recorded evicts the oldest.
+func BenchmarkHistoryBoundedDo(b *testing.B) {
+	var vals []int
+	h := NewHistory[appendCmd](10000)
+	for i := 0; i < 10000; i++ {
+		h.Do(appendCmd{&vals, i})
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.Do(appendCmd{&vals, i})
+		vals = vals[:0]
+	}
+}
@@ -0,0 +1,161 @@
+package go_data_structures
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+)
+
+// Precision of the sparse representation. Sparse entries keep 25 index bits,
+// so small cardinalities are counted almost exactly by linear counting.
+const hllSparsePrecision = 25
+
+// HyperLogLog estimates the number of distinct values in a stream using
+// 2^p small registers. The standard error is about 1.04/sqrt(2^p), e.g.
+// 0.81% at p = 14 (16 KiB of registers).
+//
+// It starts in a sparse mode that stores only the registers touched so far,
+// at a higher precision, and switches to the dense register array once the
+// sparse map holds 2^p/16 entries. A Go map entry costs roughly 16 bytes
+// with bucket overhead, so that is about when the map outgrows the registers.
+type HyperLogLog[T any] struct {
+	p         uint8
+	registers []uint8          // dense mode; nil while sparse
+	sparse    map[uint32]uint8 // sparse mode: 25-bit index -> rank
+	hasher    HashFunc[T]
+}
+
+// NewHyperLogLog creates a HyperLogLog with 2^p registers, using DefaultHash.
+// p is clamped to [4, 18].
+func NewHyperLogLog[T any](p uint8) *HyperLogLog[T] {
+	return NewHyperLogLogWithHash[T](p, DefaultHash[T])
+}
+
+// NewHyperLogLogWithHash is NewHyperLogLog with a caller-supplied hash.
+func NewHyperLogLogWithHash[T any](p uint8, hasher HashFunc[T]) *HyperLogLog[T] {
+	p = min(max(p, 4), 18)
+	return &HyperLogLog[T]{p: p, sparse: make(map[uint32]uint8), hasher: hasher}
+}
+
+// Add records val in the sketch. O(1)
+func (h *HyperLogLog[T]) Add(val T) {
+	x := h.hasher(val)
+	if h.registers == nil {
+		idx := uint32(x >> (64 - hllSparsePrecision))
+		rank := uint8(bits.LeadingZeros64(x<<hllSparsePrecision|1<<(hllSparsePrecision-1))) + 1
+		if rank > h.sparse[idx] {
+			h.sparse[idx] = rank
+		}
+		if len(h.sparse) > h.sparseLimit() {
+			h.toDense()
+		}
+		return
+	}
+	idx := x >> (64 - h.p)
+	rank := uint8(bits.LeadingZeros64(x<<h.p|1<<(h.p-1))) + 1
+	if rank > h.registers[idx] {
+		h.registers[idx] = rank
+	}
+}
+
+// sparseLimit is the number of sparse entries past which the sketch goes dense.
+func (h *HyperLogLog[T]) sparseLimit() int {
+	return (1 << h.p) / 16
+}
+
+// denseRegister converts a sparse entry to its dense register index and rank.
+func (h *HyperLogLog[T]) denseRegister(idx uint32, rank uint8) (uint32, uint8) {
+	extra := hllSparsePrecision - uint32(h.p)
+	tail := idx & (1<<extra - 1)
+	if tail != 0 {
+		// The leading zeros end inside the bits the sparse index kept.
+		return idx >> extra, uint8(extra-uint32(bits.Len32(tail))) + 1
+	}
+	return idx >> extra, uint8(extra) + rank
+}
+
+// denseRegisters returns the dense registers without changing the sketch's mode.
+func (h *HyperLogLog[T]) denseRegisters() []uint8 {
+	if h.registers != nil {
+		return h.registers
+	}
+	registers := make([]uint8, 1<<h.p)
+	for idx, rank := range h.sparse {
+		j, r := h.denseRegister(idx, rank)
+		registers[j] = max(registers[j], r)
+	}
+	return registers
+}
+
+func (h *HyperLogLog[T]) toDense() {
+	h.registers = h.denseRegisters()
+	h.sparse = nil
+}
+
+// Count returns the estimated number of distinct values added.
+func (h *HyperLogLog[T]) Count() uint64 {
+	if h.registers == nil {
+		// Linear counting over the 2^25 sparse registers.
+		m := float64(uint64(1) << hllSparsePrecision)
+		return uint64(math.Round(m * math.Log(m/(m-float64(len(h.sparse))))))
+	}
+	m := float64(len(h.registers))
+	var alpha float64
+	switch len(h.registers) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/m)
+	}
+	sum, zeros := 0.0, 0
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	estimate := alpha * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// Small-range correction: linear counting is more accurate here.
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(math.Round(estimate))
+}
+
+// Merge folds other into h, so h estimates the distinct count of both
+// streams. Both sketches must have the same precision and HashFunc.
+func (h *HyperLogLog[T]) Merge(other *HyperLogLog[T]) error {
+	if h.p != other.p {
+		return errors.New("hyperloglog: merge of sketches with different precision")
+	}
+	if h.registers == nil && other.registers == nil {
+		for idx, rank := range other.sparse {
+			if rank > h.sparse[idx] {
+				h.sparse[idx] = rank
+			}
+		}
+		if len(h.sparse) > h.sparseLimit() {
+			h.toDense()
+		}
+		return nil
+	}
+	h.toDense()
+	for i, r := range other.denseRegisters() {
+		h.registers[i] = max(h.registers[i], r)
+	}
+	return nil
+}
+
+// Precision returns p, the base-2 logarithm of the register count.
+func (h *HyperLogLog[T]) Precision() uint8 {
+	return h.p
+}
+
+// StandardError returns the expected relative error of Count, 1.04/sqrt(2^p).
+func (h *HyperLogLog[T]) StandardError() float64 {
+	return 1.04 / math.Sqrt(float64(uint64(1)<<h.p))
+}
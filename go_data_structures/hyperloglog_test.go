@@ -0,0 +1,116 @@
+package go_data_structures
+
+import (
+	"math"
+	"testing"
+)
+
+func testHash(v int) uint64 {
+	return mix64(uint64(v) + 12345)
+}
+
+func checkHLLCount(t *testing.T, h *HyperLogLog[int], want int, label string) {
+	t.Helper()
+	got := float64(h.Count())
+	if want == 0 {
+		if got != 0 {
+			t.Fatalf("%s: Count() = %v, want 0", label, got)
+		}
+		return
+	}
+	rel := math.Abs(got-float64(want)) / float64(want)
+	if rel > 4*h.StandardError() {
+		t.Fatalf("%s: Count() = %v, want %d (relative error %.4f > 4*%.4f)", label, got, want, rel, h.StandardError())
+	}
+}
+
+func TestHyperLogLogCount(t *testing.T) {
+	for _, p := range []uint8{10, 14} {
+		for _, n := range []int{0, 1, 10, 100, 1000, 5000, 20000, 200000} {
+			h := NewHyperLogLogWithHash[int](p, testHash)
+			for i := 0; i < n; i++ {
+				h.Add(i)
+				h.Add(i)
+			}
+			checkHLLCount(t, h, n, "count")
+		}
+	}
+}
+
+func TestHyperLogLogSmallPrecision(t *testing.T) {
+	// With 16 to 64 registers one run's error is too noisy to bound
+	// usefully, so average the relative error over many hash seeds: the
+	// mean must be near zero and the spread near StandardError.
+	const seeds = 32
+	for _, p := range []uint8{4, 5, 6} {
+		for _, n := range []int{10, 100, 1000, 5000, 20000, 200000} {
+			var sum, sumSq, se float64
+			for s := 0; s < seeds; s++ {
+				offset := uint64(s) * 0x9e3779b97f4a7c15
+				h := NewHyperLogLogWithHash[int](p, func(v int) uint64 { return mix64(uint64(v) + offset) })
+				for i := 0; i < n; i++ {
+					h.Add(i)
+				}
+				rel := (float64(h.Count()) - float64(n)) / float64(n)
+				sum += rel
+				sumSq += rel * rel
+				se = h.StandardError()
+			}
+			bias, rms := sum/seeds, math.Sqrt(sumSq/seeds)
+			if math.Abs(bias) > 3*se/math.Sqrt(seeds) {
+				t.Errorf("p=%d n=%d: mean relative error %.4f over %d seeds", p, n, bias, seeds)
+			}
+			if rms > 1.5*se {
+				t.Errorf("p=%d n=%d: RMS relative error %.4f, standard error %.4f", p, n, rms, se)
+			}
+		}
+	}
+}
+
+func TestHyperLogLogSparseAndDense(t *testing.T) {
+	h := NewHyperLogLogWithHash[int](14, testHash)
+	limit := h.sparseLimit()
+	for i := 0; i < limit; i++ {
+		h.Add(i)
+	}
+	if h.registers != nil {
+		t.Fatalf("sketch went dense after %d values, limit %d", limit, limit)
+	}
+	checkHLLCount(t, h, limit, "sparse")
+	for i := limit; i < 4*limit; i++ {
+		h.Add(i)
+	}
+	if h.registers == nil {
+		t.Fatalf("sketch still sparse after %d values", 4*limit)
+	}
+	checkHLLCount(t, h, 4*limit, "dense")
+}
+
+func TestHyperLogLogMerge(t *testing.T) {
+	cases := []struct {
+		name           string
+		aEnd, bStart   int
+		bEnd, distinct int
+	}{
+		{"sparse+sparse", 300, 200, 500, 500},
+		{"sparse+dense", 100, 50, 60000, 60000},
+		{"dense+dense", 50000, 25000, 80000, 80000},
+	}
+	for _, c := range cases {
+		a := NewHyperLogLogWithHash[int](12, testHash)
+		b := NewHyperLogLogWithHash[int](12, testHash)
+		for i := 0; i < c.aEnd; i++ {
+			a.Add(i)
+		}
+		for i := c.bStart; i < c.bEnd; i++ {
+			b.Add(i)
+		}
+		if err := a.Merge(b); err != nil {
+			t.Fatalf("%s: Merge: %v", c.name, err)
+		}
+		checkHLLCount(t, a, c.distinct, c.name)
+	}
+	if err := NewHyperLogLog[int](10).Merge(NewHyperLogLog[int](11)); err == nil {
+		t.Fatalf("Merge of different precisions succeeded")
+	}
+}
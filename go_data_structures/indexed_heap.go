@@ -0,0 +1,98 @@
+package go_data_structures
+
+// HeapHandle refers to one item pushed onto an IndexedHeap. It records the
+// item's current position so the heap can find it without searching.
+type HeapHandle[T any] struct {
+	value T
+	index int // position in the heap, or -1 once removed
+}
+
+// Value returns the item's current value.
+func (hh *HeapHandle[T]) Value() T {
+	return hh.value
+}
+
+// IndexedHeap is a Heap whose Push returns a handle, so an item can later
+// be re-prioritized or removed in O(log n), as Dijkstra's decrease-key and
+// schedulers need.
+type IndexedHeap[T any] struct {
+	heap *Heap[*HeapHandle[T]]
+}
+
+// NewIndexedHeap creates an empty indexed heap ordered by less.
+func NewIndexedHeap[T any](less func(a, b T) bool) *IndexedHeap[T] {
+	return &IndexedHeap[T]{heap: &Heap[*HeapHandle[T]]{
+		less:     func(a, b *HeapHandle[T]) bool { return less(a.value, b.value) },
+		setIndex: func(hh *HeapHandle[T], i int) { hh.index = i },
+	}}
+}
+
+// Push adds val and returns its handle. O(log n)
+func (ih *IndexedHeap[T]) Push(val T) *HeapHandle[T] {
+	hh := &HeapHandle[T]{value: val}
+	ih.heap.Push(hh)
+	return hh
+}
+
+// Pop removes and returns the top item, and false if the heap is empty. O(log n)
+func (ih *IndexedHeap[T]) Pop() (T, bool) {
+	hh, ok := ih.heap.Pop()
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	hh.index = -1
+	return hh.value, true
+}
+
+// Peek returns the top item without removing it. O(1)
+func (ih *IndexedHeap[T]) Peek() (T, bool) {
+	hh, ok := ih.heap.Peek()
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return hh.value, true
+}
+
+// PeekHandle returns the handle of the top item. O(1)
+func (ih *IndexedHeap[T]) PeekHandle() (*HeapHandle[T], bool) {
+	return ih.heap.Peek()
+}
+
+// Contains returns whether handle refers to an item still in this heap. O(1)
+func (ih *IndexedHeap[T]) Contains(handle *HeapHandle[T]) bool {
+	return handle != nil && handle.index >= 0 && handle.index < len(ih.heap.items) &&
+		ih.heap.items[handle.index] == handle
+}
+
+// Update changes the value of handle's item and restores heap order,
+// returning false if the item is no longer in the heap. O(log n)
+func (ih *IndexedHeap[T]) Update(handle *HeapHandle[T], val T) bool {
+	if !ih.Contains(handle) {
+		return false
+	}
+	handle.value = val
+	ih.heap.fix(handle.index)
+	return true
+}
+
+// Remove deletes handle's item, returning false if it was no longer in the heap. O(log n)
+func (ih *IndexedHeap[T]) Remove(handle *HeapHandle[T]) bool {
+	if !ih.Contains(handle) {
+		return false
+	}
+	ih.heap.removeAt(handle.index)
+	handle.index = -1
+	return true
+}
+
+// Empty returns whether the heap is empty. O(1)
+func (ih *IndexedHeap[T]) Empty() bool {
+	return ih.heap.Empty()
+}
+
+// Size returns the number of items in the heap. O(1)
+func (ih *IndexedHeap[T]) Size() int {
+	return ih.heap.Size()
+}
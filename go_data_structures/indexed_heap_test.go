@@ -0,0 +1,105 @@
+package go_data_structures
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// checkIndexedHeap verifies heap order and that every handle's index matches
+// its slot, then compares the live items with the model.
+func checkIndexedHeap(t *testing.T, ih *IndexedHeap[int], live map[*HeapHandle[int]]int, dead []*HeapHandle[int]) {
+	t.Helper()
+	items := ih.heap.items
+	for i, hh := range items {
+		if hh.index != i {
+			t.Fatalf("handle at slot %d records index %d", i, hh.index)
+		}
+		if p := ih.heap.parent(i); p >= 0 && hh.value < items[p].value {
+			t.Fatalf("slot %d (%d) is below its parent (%d)", i, hh.value, items[p].value)
+		}
+	}
+	if ih.Size() != len(live) {
+		t.Fatalf("Size() = %d, want %d", ih.Size(), len(live))
+	}
+	least, found := 0, false
+	for hh, v := range live {
+		if !ih.Contains(hh) || hh.Value() != v {
+			t.Fatalf("live handle: Contains() = %v, Value() = %d, want %d", ih.Contains(hh), hh.Value(), v)
+		}
+		if !found || v < least {
+			least, found = v, true
+		}
+	}
+	for _, hh := range dead {
+		if ih.Contains(hh) {
+			t.Fatal("Contains accepted a popped or removed handle")
+		}
+	}
+	if top, ok := ih.Peek(); ok != found || (ok && top != least) {
+		t.Fatalf("Peek() = %d, %v, want %d, %v", top, ok, least, found)
+	}
+}
+
+func TestIndexedHeapMatchesModel(t *testing.T) {
+	r := rand.New(rand.NewSource(23))
+	ih := NewIndexedHeap(func(a, b int) bool { return a < b })
+	live := make(map[*HeapHandle[int]]int)
+	var handles, dead []*HeapHandle[int]
+	other := NewIndexedHeap(func(a, b int) bool { return a < b }).Push(0)
+	// Handles never pushed onto ih: a zero handle, whose index 0 is valid
+	// whenever ih is not empty, and one from another heap.
+	strangers := []*HeapHandle[int]{{}, other}
+	dead = append(dead, strangers...)
+	for op := 0; op < 3000; op++ {
+		v := r.Intn(1000)
+		switch k := r.Intn(6); {
+		case k < 2 || len(handles) == 0:
+			hh := ih.Push(v)
+			handles = append(handles, hh)
+			live[hh] = v
+		case k == 2:
+			got, ok := ih.Pop()
+			if !ok {
+				break
+			}
+			// Pop marks the popped handle's index -1.
+			for hh, hv := range live {
+				if hh.index == -1 {
+					if hv != got {
+						t.Fatalf("Pop() = %d, but the popped handle held %d", got, hv)
+					}
+					delete(live, hh)
+					dead = append(dead, hh)
+				}
+			}
+		default:
+			hh := handles[r.Intn(len(handles))]
+			_, alive := live[hh]
+			if k == 5 {
+				if ih.Remove(hh) != alive {
+					t.Fatalf("Remove returned %v for a handle with alive=%v", !alive, alive)
+				}
+				if alive {
+					delete(live, hh)
+					dead = append(dead, hh)
+				}
+			} else {
+				old := hh.Value()
+				if ih.Update(hh, v) != alive {
+					t.Fatalf("Update returned %v for a handle with alive=%v", !alive, alive)
+				}
+				if alive {
+					live[hh] = v
+				} else if hh.Value() != old {
+					t.Fatalf("rejected Update changed the handle's value from %d to %d", old, hh.Value())
+				}
+			}
+		}
+		for _, s := range strangers {
+			if ih.Update(s, -1) || ih.Remove(s) {
+				t.Fatal("Update or Remove accepted a handle that was never pushed")
+			}
+		}
+		checkIndexedHeap(t, ih, live, dead)
+	}
+}
@@ -8,6 +8,7 @@ type SingleLinkNode[T any] struct {
 type SinglyLinkedList[T any] struct {
 	head *SingleLinkNode[T]
 	tail *SingleLinkNode[T] // Tail is added for efficient InsertAtEnd operations.
+	size int
 }
 
 type SinglyLinkedListInterface[T any] interface {
@@ -21,11 +22,11 @@ type SinglyLinkedListInterface[T any] interface {
 	Tail() *SingleLinkNode[T]                   // return last node in list. O(n)
 	Empty() bool                                // returns whether list is empty. O(1)
 	Size() int                                  // returns number of elements in list. O(1) or O(n) depending on implementation
-	PeekFront() T
-    PushBack(val T)
-    PopFront() T
-    IsEmpty() bool
-    Count() int
+	PeekFront() T                               // returns data of first node, or the zero value if empty. O(1)
+	PushBack(val T)                             // same as InsertAtEnd. O(1)
+	PopFront() T                                // removes first node and returns its data, or the zero value if empty. O(1)
+	IsEmpty() bool                              // same as Empty. O(1)
+	Count() int                                 // same as Size. O(1)
 }
 
 func NewSinglyLinkedList[T any]() *SinglyLinkedList[T] {
@@ -40,10 +41,11 @@ func (sll *SinglyLinkedList[T]) InsertAfter(val T, prev *SingleLinkNode[T]) {
 	}
 	newNode := &SingleLinkNode[T]{Data: val, Next: prev.Next}
 	prev.Next = newNode
-	if prev.Next == nil {
+	if newNode.Next == nil {
 		// Update tail if inserted at the end.
 		sll.tail = newNode
 	}
+	sll.size++
 }
 
 func (sll *SinglyLinkedList[T]) RemoveAfter(prev *SingleLinkNode[T]) {
@@ -56,6 +58,7 @@ func (sll *SinglyLinkedList[T]) RemoveAfter(prev *SingleLinkNode[T]) {
 		// Update tail if removed node was the last one.
 		sll.tail = prev
 	}
+	sll.size--
 }
 
 func (sll *SinglyLinkedList[T]) InsertAtFront(val T) {
@@ -65,6 +68,7 @@ func (sll *SinglyLinkedList[T]) InsertAtFront(val T) {
 		// If list was empty, new node is also the tail.
 		sll.tail = newNode
 	}
+	sll.size++
 }
 
 func (sll *SinglyLinkedList[T]) RemoveAtFront() {
@@ -76,6 +80,7 @@ func (sll *SinglyLinkedList[T]) RemoveAtFront() {
 		// List became empty, so update tail.
 		sll.tail = nil
 	}
+	sll.size--
 }
 
 func (sll *SinglyLinkedList[T]) InsertAtEnd(val T) {
@@ -88,6 +93,7 @@ func (sll *SinglyLinkedList[T]) InsertAtEnd(val T) {
 		sll.tail.Next = newNode
 		sll.tail = newNode
 	}
+	sll.size++
 }
 
 func (sll *SinglyLinkedList[T]) RemoveAtEnd() {
@@ -98,6 +104,7 @@ func (sll *SinglyLinkedList[T]) RemoveAtEnd() {
 		// List has only one element.
 		sll.head = nil
 		sll.tail = nil
+		sll.size = 0
 		return
 	}
 	// Iterate to find the second last node.
@@ -107,6 +114,7 @@ func (sll *SinglyLinkedList[T]) RemoveAtEnd() {
 	}
 	curr.Next = nil
 	sll.tail = curr
+	sll.size--
 }
 
 func (sll *SinglyLinkedList[T]) Head() *SingleLinkNode[T] {
@@ -121,7 +129,37 @@ func (sll *SinglyLinkedList[T]) Empty() bool {
 	return sll.head == nil
 }
 
-// Size() would require iteration over the list to count elements, as size tracking is commented out.
+func (sll *SinglyLinkedList[T]) Size() int {
+	return sll.size
+}
+
+func (sll *SinglyLinkedList[T]) PeekFront() T {
+	if sll.head == nil {
+		var zero T
+		return zero
+	}
+	return sll.head.Data
+}
+
+func (sll *SinglyLinkedList[T]) PushBack(val T) {
+	sll.InsertAtEnd(val)
+}
+
+func (sll *SinglyLinkedList[T]) PopFront() T {
+	val := sll.PeekFront()
+	sll.RemoveAtFront()
+	return val
+}
+
+func (sll *SinglyLinkedList[T]) IsEmpty() bool {
+	return sll.Empty()
+}
+
+func (sll *SinglyLinkedList[T]) Count() int {
+	return sll.size
+}
+
+var _ SinglyLinkedListInterface[int] = (*SinglyLinkedList[int])(nil)
 
 type DoubleLinkNode[T any] struct {
 	Value T
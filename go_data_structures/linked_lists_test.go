@@ -0,0 +1,43 @@
+package go_data_structures
+
+import (
+	"reflect"
+	"testing"
+)
+
+func singlyValues[T any](sll *SinglyLinkedList[T]) []T {
+	var vals []T
+	for node := sll.Head(); node != nil; node = node.Next {
+		vals = append(vals, node.Data)
+	}
+	return vals
+}
+
+func TestSinglyLinkedListSize(t *testing.T) {
+	sll := NewSinglyLinkedList[int]()
+	sll.InsertAtEnd(2)
+	sll.InsertAtFront(1)
+	sll.PushBack(4)
+	sll.InsertAfter(3, sll.Head().Next)
+	// Inserting after the tail must move the tail.
+	sll.InsertAfter(5, sll.Tail())
+	sll.InsertAtEnd(6)
+	if got := singlyValues(sll); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5, 6}) || sll.Size() != 6 || sll.Tail().Data != 6 {
+		t.Fatalf("list = %v, Size() = %d", got, sll.Size())
+	}
+	sll.RemoveAfter(sll.Head())
+	sll.RemoveAtEnd()
+	if sll.PopFront() != 1 || sll.PeekFront() != 3 {
+		t.Fatalf("PopFront/PeekFront returned the wrong items")
+	}
+	sll.RemoveAtFront()
+	if got := singlyValues(sll); !reflect.DeepEqual(got, []int{4, 5}) || sll.Count() != 2 {
+		t.Fatalf("list = %v, Count() = %d, want [4 5]", got, sll.Count())
+	}
+	sll.RemoveAtEnd()
+	sll.RemoveAtEnd()
+	sll.RemoveAtEnd()
+	if !sll.IsEmpty() || sll.Size() != 0 || sll.PopFront() != 0 {
+		t.Fatalf("list not empty: Size() = %d", sll.Size())
+	}
+}
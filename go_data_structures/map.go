@@ -24,15 +24,19 @@ type MapInterface[T constraints.Ordered, V any] interface {
 
 // Map implements a map using a slice of binary search trees for collision resolution.
 type Map [T constraints.Ordered, V any] struct {
-    arr     []*BinarySearchTree[Pair[T, V]] // Use a slice of pointers to BinarySearchTree
+    arr     []*BinarySearchTree[T, V] // Use a slice of pointers to BinarySearchTree
     maxFill float32
     size    int
 }
 
 // NewMap creates a new Map instance.
 func NewMap[T constraints.Ordered, V any](size int, maxFill float32) *Map[T, V] {
+    arr := make([]*BinarySearchTree[T, V], size)
+    for i := range arr {
+        arr[i] = NewBinarySearchTree[T, V]()
+    }
     return &Map[T, V]{
-		arr:     make([]*BinarySearchTree[Pair[T, V]], size),
+        arr:     arr,
         maxFill: maxFill,
     }
 }
@@ -47,28 +51,29 @@ func (m *Map[T, V]) hash(key T) int {
 
 // resize doubles the size of the underlying array and rehashes all keys.
 func (m *Map[T, V]) resize() {
-    newArrSize := len(m.arr) * 2
-    newArr := make([]BinarySearchTree[Pair[T, V]], newArrSize)
-    for _, tree := range m.arr {
-        for _, pair := range tree.Objects() {
-            newIndex := m.hash(pair.Key)
-            newArr[newIndex].Insert(pair)
+    oldArr := m.arr
+    m.arr = make([]*BinarySearchTree[T, V], len(oldArr)*2)
+    for i := range m.arr {
+        m.arr[i] = NewBinarySearchTree[T, V]()
+    }
+    for _, tree := range oldArr {
+        for _, pair := range tree.InOrderTraversal() {
+            m.arr[m.hash(pair.Key)].Insert(pair.Key, pair.Value)
         }
     }
-    m.arr = newArr
 }
 
 // Contains checks if the map contains the specified key.
 func (m *Map[T, V]) Contains(key T) bool {
     index := m.hash(key)
-    return m.arr[index].Contains(Pair[T, V]{Key: key})
+    return m.arr[index].Contains(key)
 }
 
 // Get retrieves the value associated with the given key.
 func (m *Map[T, V]) Get(key T) (V, error) {
     index := m.hash(key)
-    if val, found := m.arr[index].Get(Pair[T, V]{Key: key}); found {
-        return val.Value, nil
+    if val, found := m.arr[index].Get(key); found {
+        return val, nil
     }
     var zero V
     return zero, fmt.Errorf("key not found")
@@ -77,19 +82,24 @@ func (m *Map[T, V]) Get(key T) (V, error) {
 // Set inserts or updates the key-val pair in the map.
 func (m *Map[T, V]) Set(key T, val V) {
     index := m.hash(key)
-    if !m.arr[index].Contains(Pair[T, V]{Key: key}) {
+    if m.arr[index].Contains(key) {
+        // The tree keeps the first value for a key, so drop it before overwriting.
+        m.arr[index].Remove(key)
+    } else {
         m.size++
         if float32(m.size)/float32(len(m.arr)) > m.maxFill {
             m.resize()
+            index = m.hash(key)
         }
     }
-    m.arr[index].Insert(Pair[T, V]{Key: key, Value: val})
+    m.arr[index].Insert(key, val)
 }
 
 // Removes deletes the key-val pair from the map.
 func (m *Map[T, V]) Removes(key T) {
     index := m.hash(key)
-    if m.arr[index].Remove(Pair[T, V]{Key: key}) {
+    if m.arr[index].Contains(key) {
+        m.arr[index].Remove(key)
         m.size--
     }
 }
@@ -108,7 +118,7 @@ func (m *Map[T, V]) Size() int {
 func (m *Map[T, V]) Values() []V {
     var values []V
     for _, tree := range m.arr {
-        for _, pair := range tree.Objects() {
+        for _, pair := range tree.InOrderTraversal() {
             values = append(values, pair.Value)
         }
     }
@@ -119,7 +129,7 @@ func (m *Map[T, V]) Values() []V {
 func (m *Map[T, V]) Keys() []T {
     var keys []T
     for _, tree := range m.arr {
-        for _, pair := range tree.Objects() {
+        for _, pair := range tree.InOrderTraversal() {
             keys = append(keys, pair.Key)
         }
     }
@@ -130,9 +140,7 @@ func (m *Map[T, V]) Keys() []T {
 func (m *Map[T, V]) Objects() []Pair[T, V] {
     var objects []Pair[T, V]
     for _, tree := range m.arr {
-        objects = append(objects, tree.Objects()...)
+        objects = append(objects, tree.InOrderTraversal()...)
     }
     return objects
 }
-
-// BinarySearchTree and other necessary types or methods (such as `Insert`, `Remove`, `Contains`, `Objects` in BST) should be defined here.
@@ -0,0 +1,134 @@
+package go_data_structures
+
+import (
+	"runtime"
+	"sync/atomic"
+)
+
+// cacheLineSize separates indices that different goroutines write, so they
+// don't contend for the same cache line.
+const cacheLineSize = 64
+
+type mpmcCell[T any] struct {
+	// seq is the position the cell expects next: pos when it is free for the
+	// producer at pos, pos+1 once that producer has filled it.
+	seq atomic.Uint64
+	val T
+}
+
+// MPMCQueue is a bounded lock-free queue for any number of producers and
+// consumers, after Dmitry Vyukov's design: each cell carries a sequence
+// number that tells producers and consumers whether it is theirs to use,
+// so each operation is a single compare-and-swap on the shared position.
+type MPMCQueue[T any] struct {
+	_          [cacheLineSize]byte
+	enqueuePos atomic.Uint64
+	_          [cacheLineSize - 8]byte
+	dequeuePos atomic.Uint64
+	_          [cacheLineSize - 8]byte
+	cells      []mpmcCell[T]
+	mask       uint64
+}
+
+var _ QueueInterface[int] = (*MPMCQueue[int])(nil)
+
+// NewMPMCQueue creates a queue holding at most capacity items, rounded up
+// to a power of two.
+func NewMPMCQueue[T any](capacity int) *MPMCQueue[T] {
+	n := nextPowerOfTwo(max(capacity, 2))
+	q := &MPMCQueue[T]{cells: make([]mpmcCell[T], n), mask: uint64(n - 1)}
+	for i := range q.cells {
+		q.cells[i].seq.Store(uint64(i))
+	}
+	return q
+}
+
+// TryEnqueue adds val to the back, returning false if the queue is full.
+func (q *MPMCQueue[T]) TryEnqueue(val T) bool {
+	pos := q.enqueuePos.Load()
+	for {
+		cell := &q.cells[pos&q.mask]
+		switch dif := int64(cell.seq.Load() - pos); {
+		case dif == 0:
+			if q.enqueuePos.CompareAndSwap(pos, pos+1) {
+				cell.val = val
+				cell.seq.Store(pos + 1)
+				return true
+			}
+			pos = q.enqueuePos.Load()
+		case dif < 0:
+			return false // the cell still holds the item from a lap ago
+		default:
+			pos = q.enqueuePos.Load() // another producer took pos
+		}
+	}
+}
+
+// TryDequeue removes and returns the front item, and false if the queue is empty.
+func (q *MPMCQueue[T]) TryDequeue() (T, bool) {
+	pos := q.dequeuePos.Load()
+	for {
+		cell := &q.cells[pos&q.mask]
+		switch dif := int64(cell.seq.Load() - (pos + 1)); {
+		case dif == 0:
+			if q.dequeuePos.CompareAndSwap(pos, pos+1) {
+				val := cell.val
+				var zero T
+				cell.val = zero // otherwise the cell pins the item until a producer laps round
+				cell.seq.Store(pos + q.mask + 1)
+				return val, true
+			}
+			pos = q.dequeuePos.Load()
+		case dif < 0:
+			var zero T
+			return zero, false // the producer for pos hasn't finished
+		default:
+			pos = q.dequeuePos.Load() // another consumer took pos
+		}
+	}
+}
+
+// Enqueue adds val to the back, yielding the processor until there is room.
+func (q *MPMCQueue[T]) Enqueue(val T) {
+	for !q.TryEnqueue(val) {
+		runtime.Gosched()
+	}
+}
+
+// Dequeue removes the front item, if any.
+func (q *MPMCQueue[T]) Dequeue() {
+	q.TryDequeue()
+}
+
+// Front returns the front item, or the zero value if the queue is empty.
+// It must not run alongside another consumer, which could take the item
+// while it is being read.
+func (q *MPMCQueue[T]) Front() T {
+	pos := q.dequeuePos.Load()
+	cell := &q.cells[pos&q.mask]
+	if cell.seq.Load() != pos+1 {
+		var zero T
+		return zero
+	}
+	return cell.val
+}
+
+// Empty returns whether the queue is empty. Under concurrent use the answer may be stale.
+func (q *MPMCQueue[T]) Empty() bool {
+	return q.Size() == 0
+}
+
+// Size returns the number of items in the queue. Under concurrent use the answer may be stale.
+func (q *MPMCQueue[T]) Size() int {
+	dequeued := q.dequeuePos.Load()
+	enqueued := q.enqueuePos.Load()
+	if enqueued <= dequeued {
+		return 0
+	}
+	return min(int(enqueued-dequeued), len(q.cells))
+}
+
+// Cap returns the most items the queue holds.
+func (q *MPMCQueue[T]) Cap() int {
+	return len(q.cells)
+}
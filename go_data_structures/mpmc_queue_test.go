@@ -0,0 +1,140 @@
+package go_data_structures
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// mutexQueue is an AlternateQueue behind a mutex, the baseline the
+// lock-free queues are benchmarked against.
+type mutexQueue[T any] struct {
+	mu    sync.Mutex
+	queue *AlternateQueue[T]
+}
+
+func newMutexQueue[T any]() *mutexQueue[T] {
+	return &mutexQueue[T]{queue: NewAlternateQueue[T]()}
+}
+
+func (mq *mutexQueue[T]) Enqueue(val T) {
+	mq.mu.Lock()
+	mq.queue.Enqueue(val)
+	mq.mu.Unlock()
+}
+
+func (mq *mutexQueue[T]) TryDequeue() (T, bool) {
+	mq.mu.Lock()
+	defer mq.mu.Unlock()
+	if mq.queue.Empty() {
+		var zero T
+		return zero, false
+	}
+	val := mq.queue.Front()
+	mq.queue.Dequeue()
+	return val, true
+}
+
+func TestMPMCQueueExactlyOnce(t *testing.T) {
+	const producers, consumers, perProducer = 4, 4, 2000
+	q := NewMPMCQueue[int](16) // small, so producers regularly find it full
+	var taken atomic.Int64
+	var sum atomic.Int64
+	seen := make([]atomic.Int32, producers*perProducer)
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				q.Enqueue(p*perProducer + i)
+			}
+		}(p)
+	}
+	for c := 0; c < consumers; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			last := make([]int, producers) // last value seen from each producer
+			for i := range last {
+				last[i] = -1
+			}
+			for taken.Load() < producers*perProducer {
+				v, ok := q.TryDequeue()
+				if !ok {
+					runtime.Gosched()
+					continue
+				}
+				taken.Add(1)
+				sum.Add(int64(v))
+				seen[v].Add(1)
+				// One consumer must see each producer's items in the order they were put.
+				if p := v / perProducer; v <= last[p] {
+					t.Errorf("producer %d: got %d after %d", p, v, last[p])
+				} else {
+					last[p] = v
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	const n = producers * perProducer
+	if taken.Load() != n || sum.Load() != n*(n-1)/2 {
+		t.Fatalf("took %d items summing to %d, want %d summing to %d", taken.Load(), sum.Load(), n, n*(n-1)/2)
+	}
+	for v := range seen {
+		if c := seen[v].Load(); c != 1 {
+			t.Fatalf("item %d taken %d times", v, c)
+		}
+	}
+	if !q.Empty() || q.Size() != 0 {
+		t.Fatalf("queue not empty after the run: Size() = %d", q.Size())
+	}
+}
+
+func TestMPMCQueueBounds(t *testing.T) {
+	q := NewMPMCQueue[int](3)
+	if q.Cap() != 4 {
+		t.Fatalf("Cap() = %d, want 4", q.Cap())
+	}
+	for i := 0; i < 4; i++ {
+		if !q.TryEnqueue(i) {
+			t.Fatalf("TryEnqueue(%d) failed below capacity", i)
+		}
+	}
+	if q.TryEnqueue(4) || q.Size() != 4 || q.Front() != 0 {
+		t.Fatalf("TryEnqueue succeeded on a full queue")
+	}
+	for i := 0; i < 4; i++ {
+		if v, ok := q.TryDequeue(); !ok || v != i {
+			t.Fatalf("TryDequeue() = %d, %v, want %d", v, ok, i)
+		}
+	}
+	if _, ok := q.TryDequeue(); ok {
+		t.Fatalf("TryDequeue succeeded on an empty queue")
+	}
+}
+
+// BenchmarkMPMC has every goroutine enqueue then dequeue in a loop, so all
+// of them contend on both ends.
+func BenchmarkMPMC(b *testing.B) {
+	b.Run("MPMCQueue", func(b *testing.B) {
+		q := NewMPMCQueue[int](1024)
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				q.Enqueue(1)
+				q.TryDequeue()
+			}
+		})
+	})
+	b.Run("MutexAlternateQueue", func(b *testing.B) {
+		q := newMutexQueue[int]()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				q.Enqueue(1)
+				q.TryDequeue()
+			}
+		})
+	})
+}
@@ -0,0 +1,165 @@
+package go_data_structures
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+type MultiMapInterface[K constraints.Ordered, V comparable] interface {
+	Put(key K, val V)              // appends val to the values stored under key, increases size by 1. O(1) hashed, O(log n) sorted
+	GetAll(key K) []V              // returns a copy of the values stored under key, in insertion order.
+	Contains(key K) bool           // returns whether any value is stored under key.
+	RemoveValue(key K, val V) bool // removes the first occurrence of val under key, returns whether one was removed.
+	RemoveAll(key K) []V           // removes and returns every value stored under key.
+	KeyCount() int                 // returns number of distinct keys. O(1)
+	Keys() []K                     // returns all distinct keys; sorted for the sorted flavor.
+	Empty() bool                   // returns whether the multimap holds no values. O(1)
+	Size() int                     // returns total number of values across all keys. O(1)
+}
+
+// keyedStore is the subset of Map and BinarySearchTree behavior that MultiMap
+// and MultiSet are built on, so both come in hashed and sorted flavors.
+type keyedStore[K constraints.Ordered, E any] interface {
+	get(key K) (E, bool)
+	put(key K, e E)
+	remove(key K)
+	size() int
+	pairs() []Pair[K, E]
+}
+
+// hashedStore adapts Map to keyedStore.
+type hashedStore[K constraints.Ordered, E any] struct {
+	m *Map[K, E]
+}
+
+func newHashedStore[K constraints.Ordered, E any]() *hashedStore[K, E] {
+	return &hashedStore[K, E]{m: NewMap[K, E](8, 0.75)}
+}
+
+func (s *hashedStore[K, E]) get(key K) (E, bool) {
+	e, err := s.m.Get(key)
+	return e, err == nil
+}
+
+func (s *hashedStore[K, E]) put(key K, e E)      { s.m.Set(key, e) }
+func (s *hashedStore[K, E]) remove(key K)        { s.m.Removes(key) }
+func (s *hashedStore[K, E]) size() int           { return s.m.Size() }
+func (s *hashedStore[K, E]) pairs() []Pair[K, E] { return s.m.Objects() }
+
+// sortedStore adapts BinarySearchTree to keyedStore; pairs come back in key order.
+type sortedStore[K constraints.Ordered, E any] struct {
+	tree *BinarySearchTree[K, E]
+}
+
+func newSortedStore[K constraints.Ordered, E any]() *sortedStore[K, E] {
+	return &sortedStore[K, E]{tree: NewBinarySearchTree[K, E]()}
+}
+
+func (s *sortedStore[K, E]) get(key K) (E, bool) { return s.tree.Get(key) }
+
+func (s *sortedStore[K, E]) put(key K, e E) {
+	s.tree.Remove(key)
+	s.tree.Insert(key, e)
+}
+
+func (s *sortedStore[K, E]) remove(key K)        { s.tree.Remove(key) }
+func (s *sortedStore[K, E]) size() int           { return s.tree.Size() }
+func (s *sortedStore[K, E]) pairs() []Pair[K, E] { return s.tree.InOrderTraversal() }
+
+// MultiMap maps each key to a list of values. Values are kept behind a pointer
+// so appending to a key's list never has to rewrite the underlying store.
+type MultiMap[K constraints.Ordered, V comparable] struct {
+	store keyedStore[K, *[]V]
+	size  int
+}
+
+// NewMultiMap creates a MultiMap backed by the hashed Map.
+func NewMultiMap[K constraints.Ordered, V comparable]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{store: newHashedStore[K, *[]V]()}
+}
+
+// NewSortedMultiMap creates a MultiMap backed by a BinarySearchTree, so Keys
+// are returned in ascending order.
+func NewSortedMultiMap[K constraints.Ordered, V comparable]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{store: newSortedStore[K, *[]V]()}
+}
+
+// Put appends val to the values stored under key.
+func (mm *MultiMap[K, V]) Put(key K, val V) {
+	values, ok := mm.store.get(key)
+	if !ok {
+		values = &[]V{}
+		mm.store.put(key, values)
+	}
+	*values = append(*values, val)
+	mm.size++
+}
+
+// GetAll returns a copy of the values stored under key, or nil if there are none.
+func (mm *MultiMap[K, V]) GetAll(key K) []V {
+	values, ok := mm.store.get(key)
+	if !ok {
+		return nil
+	}
+	return append([]V(nil), *values...)
+}
+
+// Contains returns whether any value is stored under key.
+func (mm *MultiMap[K, V]) Contains(key K) bool {
+	_, ok := mm.store.get(key)
+	return ok
+}
+
+// RemoveValue removes the first occurrence of val under key. The key itself is
+// dropped once its last value is removed.
+func (mm *MultiMap[K, V]) RemoveValue(key K, val V) bool {
+	values, ok := mm.store.get(key)
+	if !ok {
+		return false
+	}
+	for i, v := range *values {
+		if v == val {
+			*values = append((*values)[:i], (*values)[i+1:]...)
+			mm.size--
+			if len(*values) == 0 {
+				mm.store.remove(key)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveAll removes and returns every value stored under key.
+func (mm *MultiMap[K, V]) RemoveAll(key K) []V {
+	values, ok := mm.store.get(key)
+	if !ok {
+		return nil
+	}
+	mm.store.remove(key)
+	mm.size -= len(*values)
+	return *values
+}
+
+// KeyCount returns the number of distinct keys.
+func (mm *MultiMap[K, V]) KeyCount() int {
+	return mm.store.size()
+}
+
+// Keys returns all distinct keys.
+func (mm *MultiMap[K, V]) Keys() []K {
+	var keys []K
+	for _, pair := range mm.store.pairs() {
+		keys = append(keys, pair.Key)
+	}
+	return keys
+}
+
+// Empty returns whether the multimap holds no values.
+func (mm *MultiMap[K, V]) Empty() bool {
+	return mm.size == 0
+}
+
+// Size returns the total number of values across all keys.
+func (mm *MultiMap[K, V]) Size() int {
+	return mm.size
+}
@@ -0,0 +1,57 @@
+package go_data_structures
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMultiMap(t *testing.T) {
+	for _, flavor := range []struct {
+		name string
+		new  func() *MultiMap[string, int]
+	}{
+		{"hashed", NewMultiMap[string, int]},
+		{"sorted", NewSortedMultiMap[string, int]},
+	} {
+		mm := flavor.new()
+		if !mm.Empty() || mm.GetAll("a") != nil || mm.RemoveValue("a", 1) || mm.RemoveAll("a") != nil {
+			t.Fatalf("%s: empty multimap returned values", flavor.name)
+		}
+		mm.Put("b", 1)
+		mm.Put("a", 2)
+		mm.Put("b", 3)
+		mm.Put("b", 1)
+		if mm.Size() != 4 || mm.KeyCount() != 2 {
+			t.Fatalf("%s: Size() = %d, KeyCount() = %d, want 4 and 2", flavor.name, mm.Size(), mm.KeyCount())
+		}
+		if got := mm.GetAll("b"); !reflect.DeepEqual(got, []int{1, 3, 1}) {
+			t.Fatalf("%s: GetAll(b) = %v, want [1 3 1]", flavor.name, got)
+		}
+		// GetAll returns a copy.
+		mm.GetAll("b")[0] = 99
+		if mm.GetAll("b")[0] != 1 {
+			t.Fatalf("%s: writing to GetAll's result changed the multimap", flavor.name)
+		}
+		if !mm.RemoveValue("b", 1) || mm.RemoveValue("b", 7) {
+			t.Fatalf("%s: RemoveValue reported the wrong result", flavor.name)
+		}
+		if got := mm.GetAll("b"); !reflect.DeepEqual(got, []int{3, 1}) || mm.Size() != 3 {
+			t.Fatalf("%s: after RemoveValue GetAll(b) = %v, Size() = %d", flavor.name, got, mm.Size())
+		}
+		keys := mm.Keys()
+		if flavor.name == "hashed" {
+			sort.Strings(keys)
+		}
+		if !reflect.DeepEqual(keys, []string{"a", "b"}) {
+			t.Fatalf("%s: Keys() = %v, want [a b]", flavor.name, keys)
+		}
+		// Removing a key's last value drops the key.
+		if !mm.RemoveValue("a", 2) || mm.Contains("a") || mm.KeyCount() != 1 {
+			t.Fatalf("%s: key a survived losing its last value", flavor.name)
+		}
+		if got := mm.RemoveAll("b"); !reflect.DeepEqual(got, []int{3, 1}) || !mm.Empty() || mm.KeyCount() != 0 {
+			t.Fatalf("%s: RemoveAll(b) = %v, Size() = %d", flavor.name, got, mm.Size())
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package go_data_structures
+
+import (
+	"sort"
+
+	"golang.org/x/exp/constraints"
+)
+
+type MultiSetInterface[T constraints.Ordered] interface {
+	Add(val T, n int)                // adds n occurrences of val, increases size by n. O(1) hashed, O(log n) sorted
+	Remove(val T, n int) int         // removes up to n occurrences of val, returns how many were removed.
+	Count(val T) int                 // returns number of occurrences of val.
+	Contains(val T) bool             // returns whether val occurs at least once.
+	MostCommon(k int) []Pair[T, int] // returns the k most frequent elements with their counts.
+	Distinct() []T                   // returns each element once; sorted for the sorted flavor.
+	DistinctCount() int              // returns number of distinct elements. O(1)
+	Empty() bool                     // returns whether the multiset is empty. O(1)
+	Size() int                       // returns total number of occurrences. O(1)
+}
+
+// MultiSet (bag) counts occurrences of each element.
+type MultiSet[T constraints.Ordered] struct {
+	store keyedStore[T, *int]
+	size  int
+}
+
+// NewMultiSet creates a MultiSet backed by the hashed Map.
+func NewMultiSet[T constraints.Ordered]() *MultiSet[T] {
+	return &MultiSet[T]{store: newHashedStore[T, *int]()}
+}
+
+// NewSortedMultiSet creates a MultiSet backed by a BinarySearchTree, so Distinct
+// returns elements in ascending order.
+func NewSortedMultiSet[T constraints.Ordered]() *MultiSet[T] {
+	return &MultiSet[T]{store: newSortedStore[T, *int]()}
+}
+
+// Add adds n occurrences of val. Non-positive n is a no-op.
+func (ms *MultiSet[T]) Add(val T, n int) {
+	if n <= 0 {
+		return
+	}
+	count, ok := ms.store.get(val)
+	if !ok {
+		count = new(int)
+		ms.store.put(val, count)
+	}
+	*count += n
+	ms.size += n
+}
+
+// Remove removes up to n occurrences of val and returns how many were removed.
+func (ms *MultiSet[T]) Remove(val T, n int) int {
+	count, ok := ms.store.get(val)
+	if !ok || n <= 0 {
+		return 0
+	}
+	if n >= *count {
+		n = *count
+		ms.store.remove(val)
+	}
+	*count -= n
+	ms.size -= n
+	return n
+}
+
+// Count returns the number of occurrences of val.
+func (ms *MultiSet[T]) Count(val T) int {
+	if count, ok := ms.store.get(val); ok {
+		return *count
+	}
+	return 0
+}
+
+// Contains returns whether val occurs at least once.
+func (ms *MultiSet[T]) Contains(val T) bool {
+	_, ok := ms.store.get(val)
+	return ok
+}
+
+// MostCommon returns up to k elements with the highest counts, most frequent
+// first. Ties are broken by ascending element order so results are stable.
+func (ms *MultiSet[T]) MostCommon(k int) []Pair[T, int] {
+	var counts []Pair[T, int]
+	for _, pair := range ms.store.pairs() {
+		counts = append(counts, Pair[T, int]{Key: pair.Key, Value: *pair.Value})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Value != counts[j].Value {
+			return counts[i].Value > counts[j].Value
+		}
+		return counts[i].Key < counts[j].Key
+	})
+	if k < len(counts) {
+		counts = counts[:max(k, 0)]
+	}
+	return counts
+}
+
+// Distinct returns each element once.
+func (ms *MultiSet[T]) Distinct() []T {
+	var values []T
+	for _, pair := range ms.store.pairs() {
+		values = append(values, pair.Key)
+	}
+	return values
+}
+
+// DistinctCount returns the number of distinct elements.
+func (ms *MultiSet[T]) DistinctCount() int {
+	return ms.store.size()
+}
+
+// Empty returns whether the multiset is empty.
+func (ms *MultiSet[T]) Empty() bool {
+	return ms.size == 0
+}
+
+// Size returns the total number of occurrences across all elements.
+func (ms *MultiSet[T]) Size() int {
+	return ms.size
+}
@@ -0,0 +1,57 @@
+package go_data_structures
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMultiSet(t *testing.T) {
+	for _, flavor := range []struct {
+		name string
+		new  func() *MultiSet[string]
+	}{
+		{"hashed", NewMultiSet[string]},
+		{"sorted", NewSortedMultiSet[string]},
+	} {
+		ms := flavor.new()
+		ms.Add("b", 3)
+		ms.Add("a", 1)
+		ms.Add("c", 3)
+		ms.Add("a", 0)
+		ms.Add("a", -2)
+		if ms.Size() != 7 || ms.DistinctCount() != 3 || ms.Count("a") != 1 || ms.Count("z") != 0 {
+			t.Fatalf("%s: Size() = %d, DistinctCount() = %d, Count(a) = %d", flavor.name, ms.Size(), ms.DistinctCount(), ms.Count("a"))
+		}
+		want := []Pair[string, int]{{Key: "b", Value: 3}, {Key: "c", Value: 3}}
+		if got := ms.MostCommon(2); !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s: MostCommon(2) = %v, want %v", flavor.name, got, want)
+		}
+		if got := ms.MostCommon(10); len(got) != 3 || len(ms.MostCommon(-1)) != 0 {
+			t.Fatalf("%s: MostCommon(10) returned %d elements", flavor.name, len(got))
+		}
+		distinct := ms.Distinct()
+		if flavor.name == "hashed" {
+			sort.Strings(distinct)
+		}
+		if !reflect.DeepEqual(distinct, []string{"a", "b", "c"}) {
+			t.Fatalf("%s: Distinct() = %v", flavor.name, distinct)
+		}
+
+		if n := ms.Remove("b", 2); n != 2 || ms.Count("b") != 1 || ms.Size() != 5 {
+			t.Fatalf("%s: Remove(b, 2) = %d, Count(b) = %d", flavor.name, n, ms.Count("b"))
+		}
+		// Removing more than are present removes them all and drops the element.
+		if n := ms.Remove("c", 10); n != 3 || ms.Contains("c") || ms.DistinctCount() != 2 {
+			t.Fatalf("%s: Remove(c, 10) = %d, Contains(c) = %v", flavor.name, n, ms.Contains("c"))
+		}
+		if ms.Remove("z", 1) != 0 || ms.Remove("a", 0) != 0 || ms.Size() != 2 {
+			t.Fatalf("%s: Remove of nothing changed the multiset", flavor.name)
+		}
+		ms.Remove("a", 1)
+		ms.Remove("b", 1)
+		if !ms.Empty() || ms.DistinctCount() != 0 {
+			t.Fatalf("%s: multiset not empty after removing everything", flavor.name)
+		}
+	}
+}
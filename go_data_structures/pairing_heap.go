@@ -0,0 +1,198 @@
+package go_data_structures
+
+// PairingHeapNode is one item in a PairingHeap; Insert returns it as a
+// handle for DecreaseKey and Delete.
+type PairingHeapNode[T any] struct {
+	value   T
+	child   *PairingHeapNode[T] // leftmost child
+	sibling *PairingHeapNode[T] // next sibling to the right
+	prev    *PairingHeapNode[T] // parent if leftmost child, otherwise left sibling
+	inHeap  bool
+}
+
+// Value returns the node's current value.
+func (n *PairingHeapNode[T]) Value() T {
+	return n.value
+}
+
+// PairingHeap is a meldable heap ordered by less. Insert, Peek and Meld are
+// O(1); Pop and Delete are amortized O(log n), and DecreaseKey is cheap in
+// practice.
+type PairingHeap[T any] struct {
+	root *PairingHeapNode[T]
+	size int
+	less func(a, b T) bool
+}
+
+var _ HeapInterface[int] = (*PairingHeap[int])(nil)
+
+// NewPairingHeap creates an empty pairing heap ordered by less.
+func NewPairingHeap[T any](less func(a, b T) bool) *PairingHeap[T] {
+	return &PairingHeap[T]{less: less}
+}
+
+// link makes the root that sorts later the leftmost child of the other one,
+// returning the new root.
+func (ph *PairingHeap[T]) link(a, b *PairingHeapNode[T]) *PairingHeapNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if ph.less(b.value, a.value) {
+		a, b = b, a
+	}
+	b.prev = a
+	b.sibling = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	a.prev, a.sibling = nil, nil
+	return a
+}
+
+// Insert adds val and returns its node. O(1)
+func (ph *PairingHeap[T]) Insert(val T) *PairingHeapNode[T] {
+	node := &PairingHeapNode[T]{value: val, inHeap: true}
+	ph.root = ph.link(ph.root, node)
+	ph.size++
+	return node
+}
+
+func (ph *PairingHeap[T]) Push(val T) {
+	ph.Insert(val)
+}
+
+func (ph *PairingHeap[T]) Peek() (T, bool) {
+	if ph.root == nil {
+		var zero T
+		return zero, false
+	}
+	return ph.root.value, true
+}
+
+// Pop removes and returns the top item. Amortized O(log n)
+func (ph *PairingHeap[T]) Pop() (T, bool) {
+	if ph.root == nil {
+		var zero T
+		return zero, false
+	}
+	top := ph.root
+	ph.root = ph.mergePairs(top.child)
+	ph.size--
+	top.child, top.inHeap = nil, false
+	return top.value, true
+}
+
+// mergePairs melds a list of siblings with the standard two-pass scheme:
+// link them in pairs left to right, then fold the pairs right to left.
+func (ph *PairingHeap[T]) mergePairs(first *PairingHeapNode[T]) *PairingHeapNode[T] {
+	var pairs []*PairingHeapNode[T]
+	for first != nil {
+		a, b := first, first.sibling
+		if b == nil {
+			first = nil
+		} else {
+			first = b.sibling
+		}
+		a.prev, a.sibling = nil, nil
+		if b != nil {
+			b.prev, b.sibling = nil, nil
+		}
+		pairs = append(pairs, ph.link(a, b))
+	}
+	var root *PairingHeapNode[T]
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = ph.link(pairs[i], root)
+	}
+	return root
+}
+
+// PushPop pushes val and then pops the top item.
+func (ph *PairingHeap[T]) PushPop(val T) T {
+	if ph.root == nil || !ph.less(ph.root.value, val) {
+		return val
+	}
+	top, _ := ph.Pop()
+	ph.Insert(val)
+	return top
+}
+
+// Replace pops the top item and then pushes val.
+func (ph *PairingHeap[T]) Replace(val T) (T, bool) {
+	top, ok := ph.Pop()
+	ph.Insert(val)
+	return top, ok
+}
+
+// DecreaseKey moves node toward the top by giving it val, which must not
+// sort after its current value. It returns false if the node is no longer
+// in the heap or val would move it down. node must belong to ph.
+func (ph *PairingHeap[T]) DecreaseKey(node *PairingHeapNode[T], val T) bool {
+	if node == nil || !node.inHeap || ph.less(node.value, val) {
+		return false
+	}
+	if node != ph.root && node.prev == nil {
+		// The root of some other heap; nothing here to cut it from.
+		return false
+	}
+	node.value = val
+	if node == ph.root {
+		return true
+	}
+	// Cut node's subtree out of its sibling list and meld it with the root.
+	ph.detach(node)
+	ph.root = ph.link(ph.root, node)
+	return true
+}
+
+// Delete removes node from the heap. It returns false if the node is no
+// longer in the heap. node must belong to ph. Amortized O(log n)
+func (ph *PairingHeap[T]) Delete(node *PairingHeapNode[T]) bool {
+	if node == nil || !node.inHeap {
+		return false
+	}
+	if node == ph.root {
+		ph.Pop()
+		return true
+	}
+	if node.prev == nil {
+		return false
+	}
+	ph.detach(node)
+	ph.root = ph.link(ph.root, ph.mergePairs(node.child))
+	ph.size--
+	node.child, node.inHeap = nil, false
+	return true
+}
+
+// detach cuts node's subtree out of its sibling list.
+func (ph *PairingHeap[T]) detach(node *PairingHeapNode[T]) {
+	if node.prev.child == node {
+		node.prev.child = node.sibling
+	} else {
+		node.prev.sibling = node.sibling
+	}
+	if node.sibling != nil {
+		node.sibling.prev = node.prev
+	}
+	node.prev, node.sibling = nil, nil
+}
+
+// Meld moves every item of other into ph in O(1), leaving other empty.
+// Nodes from other remain valid handles, now for ph.
+func (ph *PairingHeap[T]) Meld(other *PairingHeap[T]) {
+	ph.root = ph.link(ph.root, other.root)
+	ph.size += other.size
+	other.root, other.size = nil, 0
+}
+
+func (ph *PairingHeap[T]) Empty() bool {
+	return ph.root == nil
+}
+
+func (ph *PairingHeap[T]) Size() int {
+	return ph.size
+}
@@ -0,0 +1,133 @@
+package go_data_structures
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// meldableHeap is the handle-based API shared by PairingHeap and FibonacciHeap.
+type meldableHeap[N comparable, H any] interface {
+	Insert(val int) N
+	Pop() (int, bool)
+	DecreaseKey(node N, val int) bool
+	Delete(node N) bool
+	Meld(other H)
+	Size() int
+}
+
+// testMeldableHeap runs a random mix of Insert, Pop, DecreaseKey, Delete and
+// Meld against a plain list of live values, then drains the heap and checks
+// it against the sorted list.
+func testMeldableHeap[N comparable, H meldableHeap[N, H]](t *testing.T, newHeap func() H) {
+	type ref struct {
+		node  N
+		value int
+		heap  int // 0 for a, 1 for b
+		alive bool
+	}
+	r := rand.New(rand.NewSource(9))
+	for it := 0; it < 30; it++ {
+		heaps := [2]H{newHeap(), newHeap()}
+		var refs []*ref
+		live := func(heap int) []*ref {
+			var out []*ref
+			for _, x := range refs {
+				if x.alive && x.heap == heap {
+					out = append(out, x)
+				}
+			}
+			return out
+		}
+		for op := 0; op < 1500; op++ {
+			switch r.Intn(7) {
+			case 0, 1:
+				// The low digits keep values distinct, so Pop's result names one node.
+				h, v := r.Intn(2), r.Intn(10000)*100000+len(refs)
+				refs = append(refs, &ref{node: heaps[h].Insert(v), value: v, heap: h, alive: true})
+			case 2:
+				if c := live(0); len(c) > 0 {
+					x := c[r.Intn(len(c))]
+					v := x.value - r.Intn(500)*100000
+					if !heaps[0].DecreaseKey(x.node, v) {
+						t.Fatalf("DecreaseKey(%d -> %d) = false", x.value, v)
+					}
+					x.value = v
+					if heaps[0].DecreaseKey(x.node, v+1) {
+						t.Fatalf("DecreaseKey accepted an increase")
+					}
+				}
+			case 3:
+				if c := live(0); len(c) > 0 {
+					x := c[r.Intn(len(c))]
+					if !heaps[0].Delete(x.node) {
+						t.Fatalf("Delete(%d) = false", x.value)
+					}
+					x.alive = false
+					if heaps[0].Delete(x.node) || heaps[0].DecreaseKey(x.node, x.value-1) {
+						t.Fatalf("deleted node still accepted")
+					}
+				}
+			case 4:
+				c := live(0)
+				got, ok := heaps[0].Pop()
+				if ok != (len(c) > 0) {
+					t.Fatalf("Pop ok = %v with %d live items", ok, len(c))
+				}
+				if !ok {
+					continue
+				}
+				best := c[0]
+				for _, x := range c {
+					if x.value < best.value {
+						best = x
+					}
+				}
+				if got != best.value {
+					t.Fatalf("Pop() = %d, want %d", got, best.value)
+				}
+				best.alive = false
+			case 5:
+				if r.Intn(10) == 0 {
+					heaps[0].Meld(heaps[1])
+					for _, x := range refs {
+						x.heap = 0
+					}
+				}
+			case 6:
+				if c := live(1); len(c) > 0 && r.Intn(4) == 0 {
+					x := c[r.Intn(len(c))]
+					if !heaps[1].Delete(x.node) {
+						t.Fatalf("Delete(%d) in second heap = false", x.value)
+					}
+					x.alive = false
+				}
+			}
+			if heaps[0].Size() != len(live(0)) || heaps[1].Size() != len(live(1)) {
+				t.Fatalf("sizes %d/%d, want %d/%d", heaps[0].Size(), heaps[1].Size(), len(live(0)), len(live(1)))
+			}
+		}
+		heaps[0].Meld(heaps[1])
+		var want []int
+		for _, x := range refs {
+			if x.alive {
+				want = append(want, x.value)
+			}
+		}
+		sort.Ints(want)
+		for i, w := range want {
+			if got, ok := heaps[0].Pop(); !ok || got != w {
+				t.Fatalf("drain %d: Pop() = %d, %v, want %d", i, got, ok, w)
+			}
+		}
+		if _, ok := heaps[0].Pop(); ok {
+			t.Fatalf("heap not empty after drain")
+		}
+	}
+}
+
+func TestPairingHeapHandles(t *testing.T) {
+	testMeldableHeap[*PairingHeapNode[int]](t, func() *PairingHeap[int] {
+		return NewPairingHeap(func(a, b int) bool { return a < b })
+	})
+}
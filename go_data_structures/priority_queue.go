@@ -5,64 +5,146 @@ import (
 )
 
 type PriorityQueueInterface[T constraints.Ordered, V any] interface {
-	Front() Pair[T, V]         // returns first item in queue. O(1)
-	Enqueue(priority T, val V) // adds val to queue with priority, increases size by 1. O(1)
-	Dequeue()                  // removes the highest priority item form queue, decreases size by 1. O(1)
-	Empty() bool               // returns whether queue is empty. O(1)
-	Size() int                 // returns number of elements in queue. O(1)
+	Front() (Pair[T, V], bool)                            // returns first item in queue, returns false if empty. O(1)
+	Enqueue(priority T, val V) *PriorityQueueHandle[T, V] // adds val with priority and returns its handle, increases size by 1. O(log n)
+	Dequeue() (Pair[T, V], bool)                          // removes and returns the first item, returns false if empty. O(log n)
+	Empty() bool                                          // returns whether queue is empty. O(1)
+	Size() int                                            // returns number of elements in queue. O(1)
 }
 
 
 
-// PriorityQueue implements a priority queue using a heap.
+// PriorityQueue implements a priority queue using a heap. By default the
+// highest priority comes out first; NewMinPriorityQueue reverses that. Items
+// of equal priority come out in the order they were enqueued.
 type PriorityQueue[T constraints.Ordered, V any] struct {
-	items []Pair[T, V]
+	items    []*PriorityQueueHandle[T, V]
+	minFirst bool
+	seq      uint64 // sequence number for the next item pushed
 }
 
+// PriorityQueueHandle refers to one item enqueued on a PriorityQueue, so it
+// can later be re-prioritized or removed without searching.
+type PriorityQueueHandle[T constraints.Ordered, V any] struct {
+	pair  Pair[T, V]
+	seq   uint64 // breaks ties between equal priorities
+	index int    // position in the queue, or -1 once removed
+}
+
+// Priority returns the item's current priority.
+func (h *PriorityQueueHandle[T, V]) Priority() T {
+	return h.pair.Priority
+}
+
+// Value returns the item's value.
+func (h *PriorityQueueHandle[T, V]) Value() V {
+	return h.pair.Value
+}
+
+var _ PriorityQueueInterface[int, any] = (*PriorityQueue[int, any])(nil)
+
 // ensure PriorityQueue implements heap.Interface
 var _ heap.Interface = (*PriorityQueue[int, any])(nil)
 
 // Implement heap.Interface for PriorityQueue.
 func (pq *PriorityQueue[T, V]) Len() int { return len(pq.items) }
 func (pq *PriorityQueue[T, V]) Less(i, j int) bool {
-	// We want Pop to give us the highest, not lowest, priority so we use greater than here.
-	return pq.items[i].Priority > pq.items[j].Priority
+	a, b := pq.items[i], pq.items[j]
+	if a.pair.Priority == b.pair.Priority {
+		return a.seq < b.seq
+	}
+	if pq.minFirst {
+		return a.pair.Priority < b.pair.Priority
+	}
+	return a.pair.Priority > b.pair.Priority
 }
 func (pq *PriorityQueue[T, V]) Swap(i, j int) {
 	pq.items[i], pq.items[j] = pq.items[j], pq.items[i]
+	pq.items[i].index = i
+	pq.items[j].index = j
 }
 func (pq *PriorityQueue[T, V]) Push(x any) {
-	item := x.(Pair[T, V])
+	item := x.(*PriorityQueueHandle[T, V])
+	item.index = len(pq.items)
 	pq.items = append(pq.items, item)
 }
 func (pq *PriorityQueue[T, V]) Pop() any {
 	old := pq.items
 	n := len(old)
 	item := old[n-1]
+	old[n-1] = nil
+	item.index = -1
 	pq.items = old[0 : n-1]
 	return item
 }
 
 // NewPriorityQueue creates a new PriorityQueue.
 func NewPriorityQueue[T constraints.Ordered, V any]() *PriorityQueue[T, V] {
-	pq := &PriorityQueue[T, V]{}
-	heap.Init(pq)
-	return pq
+	return &PriorityQueue[T, V]{}
+}
+
+// NewMinPriorityQueue creates a new PriorityQueue that serves the lowest priority first.
+func NewMinPriorityQueue[T constraints.Ordered, V any]() *PriorityQueue[T, V] {
+	return &PriorityQueue[T, V]{minFirst: true}
+}
+
+// Front returns the first item in the queue without removing it, and false if the queue is empty.
+func (pq *PriorityQueue[T, V]) Front() (Pair[T, V], bool) {
+	if len(pq.items) == 0 {
+		return Pair[T, V]{}, false
+	}
+	return pq.items[0].pair, true
+}
+
+// Enqueue adds an item to the queue with a given priority and returns its handle.
+func (pq *PriorityQueue[T, V]) Enqueue(priority T, val V) *PriorityQueueHandle[T, V] {
+	item := &PriorityQueueHandle[T, V]{pair: Pair[T, V]{Priority: priority, Value: val}, seq: pq.seq}
+	pq.seq++
+	heap.Push(pq, item)
+	return item
+}
+
+// Dequeue removes and returns the first item in the queue, and false if the queue is empty.
+func (pq *PriorityQueue[T, V]) Dequeue() (Pair[T, V], bool) {
+	if len(pq.items) == 0 {
+		return Pair[T, V]{}, false
+	}
+	return heap.Pop(pq).(*PriorityQueueHandle[T, V]).pair, true
+}
+
+// Contains returns whether handle refers to an item still in this queue.
+func (pq *PriorityQueue[T, V]) Contains(handle *PriorityQueueHandle[T, V]) bool {
+	return handle != nil && handle.index >= 0 && handle.index < len(pq.items) &&
+		pq.items[handle.index] == handle
 }
 
-// Front returns the first item in the queue without removing it.
-func (pq *PriorityQueue[T, V]) Front() Pair[T, V] {
-	return pq.items[0]
+// Peek returns handle's item, and false if it is no longer in the queue.
+func (pq *PriorityQueue[T, V]) Peek(handle *PriorityQueueHandle[T, V]) (Pair[T, V], bool) {
+	if !pq.Contains(handle) {
+		return Pair[T, V]{}, false
+	}
+	return handle.pair, true
 }
 
-// Enqueue adds an item to the queue with a given priority.
-func (pq *PriorityQueue[T, V]) Enqueue(priority T, val V) {
-	heap.Push(pq, Pair[T, V]{Priority: priority, Value: val})
+// UpdatePriority changes the priority of handle's item, keeping its place
+// among items of equal priority. It returns false if the item is no longer
+// in the queue. O(log n)
+func (pq *PriorityQueue[T, V]) UpdatePriority(handle *PriorityQueueHandle[T, V], priority T) bool {
+	if !pq.Contains(handle) {
+		return false
+	}
+	handle.pair.Priority = priority
+	heap.Fix(pq, handle.index)
+	return true
 }
 
-// Dequeue removes and returns the highest priority item from the queue.
-func (pq *PriorityQueue[T, V]) Dequeue() {
-	heap.Pop(pq)
+// Remove deletes handle's item, returning false if it was no longer in the queue. O(log n)
+func (pq *PriorityQueue[T, V]) Remove(handle *PriorityQueueHandle[T, V]) bool {
+	if !pq.Contains(handle) {
+		return false
+	}
+	heap.Remove(pq, handle.index)
+	return true
 }
 
 // Empty returns whether the queue is empty.
@@ -0,0 +1,134 @@
+package go_data_structures
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestPriorityQueueEqualPrioritiesAreFIFO(t *testing.T) {
+	r := rand.New(rand.NewSource(25))
+	for _, minFirst := range []bool{false, true} {
+		pq := NewPriorityQueue[int, int]()
+		if minFirst {
+			pq = NewMinPriorityQueue[int, int]()
+		}
+		// Few distinct priorities, so every priority is shared by many items;
+		// the value is the enqueue order.
+		var want []Pair[int, int]
+		for i := 0; i < 500; i++ {
+			p := r.Intn(5)
+			pq.Enqueue(p, i)
+			want = append(want, Pair[int, int]{Priority: p, Value: i})
+		}
+		sort.SliceStable(want, func(i, j int) bool {
+			if minFirst {
+				return want[i].Priority < want[j].Priority
+			}
+			return want[i].Priority > want[j].Priority
+		})
+		for _, w := range want {
+			if got, ok := pq.Dequeue(); !ok || got != w {
+				t.Fatalf("minFirst=%v: Dequeue() = %v, %v, want %v", minFirst, got, ok, w)
+			}
+		}
+		if _, ok := pq.Dequeue(); ok {
+			t.Fatalf("minFirst=%v: Dequeue() on an empty queue succeeded", minFirst)
+		}
+	}
+}
+
+// pqEntry is the reference model's copy of one queued item.
+type pqEntry struct {
+	handle   *PriorityQueueHandle[int, int]
+	priority int
+	seq      int // enqueue order, which breaks ties
+}
+
+func TestPriorityQueueHandlesMatchModel(t *testing.T) {
+	r := rand.New(rand.NewSource(26))
+	pq := NewMinPriorityQueue[int, int]()
+	var live []pqEntry
+	var stale []*PriorityQueueHandle[int, int]
+	// first returns the index in live of the item Dequeue should return.
+	first := func() int {
+		best := 0
+		for i, e := range live {
+			if e.priority < live[best].priority || (e.priority == live[best].priority && e.seq < live[best].seq) {
+				best = i
+			}
+		}
+		return best
+	}
+	retire := func(i int) {
+		stale = append(stale, live[i].handle)
+		live = append(live[:i], live[i+1:]...)
+	}
+	for op := 0; op < 3000; op++ {
+		switch k := r.Intn(5); {
+		case k < 2 || len(live) == 0:
+			p := r.Intn(50)
+			h := pq.Enqueue(p, op)
+			live = append(live, pqEntry{handle: h, priority: p, seq: op})
+		case k == 2:
+			i := first()
+			got, ok := pq.Dequeue()
+			if !ok || got.Value != live[i].seq || got.Priority != live[i].priority {
+				t.Fatalf("Dequeue() = %v, %v, want priority %d value %d", got, ok, live[i].priority, live[i].seq)
+			}
+			retire(i)
+		case k == 3:
+			i := r.Intn(len(live))
+			p := r.Intn(50)
+			if !pq.UpdatePriority(live[i].handle, p) {
+				t.Fatal("UpdatePriority rejected a live handle")
+			}
+			live[i].priority = p
+		case k == 4:
+			i := r.Intn(len(live))
+			if !pq.Remove(live[i].handle) {
+				t.Fatal("Remove rejected a live handle")
+			}
+			retire(i)
+		}
+
+		if pq.Size() != len(live) {
+			t.Fatalf("Size() = %d, want %d", pq.Size(), len(live))
+		}
+		if len(live) > 0 {
+			e := live[first()]
+			if got, ok := pq.Front(); !ok || got.Value != e.seq {
+				t.Fatalf("Front() = %v, %v, want value %d", got, ok, e.seq)
+			}
+		}
+		for _, e := range live {
+			got, ok := pq.Peek(e.handle)
+			if !ok || !pq.Contains(e.handle) || got.Priority != e.priority || got.Value != e.seq ||
+				e.handle.Priority() != e.priority || e.handle.Value() != e.seq {
+				t.Fatalf("Peek(handle of %d) = %v, %v, want priority %d", e.seq, got, ok, e.priority)
+			}
+		}
+		// Handles that were dequeued or removed are rejected everywhere.
+		if len(stale) > 0 {
+			h := stale[r.Intn(len(stale))]
+			if pq.Contains(h) || pq.UpdatePriority(h, 0) || pq.Remove(h) {
+				t.Fatalf("a stale handle (value %d) was accepted", h.Value())
+			}
+			if got, ok := pq.Peek(h); ok || got != (Pair[int, int]{}) {
+				t.Fatalf("Peek(stale handle) = %v, %v", got, ok)
+			}
+		}
+	}
+
+	for !pq.Empty() {
+		pq.Dequeue()
+	}
+	for _, h := range []*PriorityQueueHandle[int, int]{nil, {}, stale[0]} {
+		if got, ok := pq.Peek(h); ok || got != (Pair[int, int]{}) {
+			t.Fatalf("Peek on an empty queue = %v, %v", got, ok)
+		}
+	}
+	if got, ok := pq.Front(); ok || got != (Pair[int, int]{}) {
+		t.Fatalf("Front() on an empty queue = %v, %v", got, ok)
+	}
+}
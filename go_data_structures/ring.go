@@ -0,0 +1,73 @@
+package go_data_structures
+
+// ring is the circular buffer behind RingDeque and RingBuffer. Its length is
+// always zero or a power of two, so positions wrap with a mask instead of a
+// division. Callers make room before pushing.
+type ring[T any] struct {
+	buf  []T
+	head int // index in buf of the first item
+	size int
+}
+
+// slot returns the index in buf of the i-th item.
+func (r *ring[T]) slot(i int) int {
+	return (r.head + i) & (len(r.buf) - 1)
+}
+
+func (r *ring[T]) at(i int) T {
+	return r.buf[r.slot(i)]
+}
+
+// resize moves the items into a new buffer of capacity items, which must be
+// a power of two no smaller than size.
+func (r *ring[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	if r.size > 0 {
+		n := copy(buf, r.buf[r.head:min(r.head+r.size, len(r.buf))])
+		copy(buf[n:], r.buf[:r.size-n])
+	}
+	r.buf, r.head = buf, 0
+}
+
+func (r *ring[T]) pushBack(val T) {
+	r.buf[r.slot(r.size)] = val
+	r.size++
+}
+
+func (r *ring[T]) pushFront(val T) {
+	r.head = (r.head - 1) & (len(r.buf) - 1)
+	r.buf[r.head] = val
+	r.size++
+}
+
+func (r *ring[T]) popFront() T {
+	var zero T
+	val := r.buf[r.head]
+	r.buf[r.head] = zero
+	r.head = (r.head + 1) & (len(r.buf) - 1)
+	r.size--
+	return val
+}
+
+func (r *ring[T]) popBack() T {
+	var zero T
+	i := r.slot(r.size - 1)
+	val := r.buf[i]
+	r.buf[i] = zero
+	r.size--
+	return val
+}
+
+func (r *ring[T]) clear() {
+	clear(r.buf)
+	r.head, r.size = 0, 0
+}
+
+// nextPowerOfTwo returns the smallest power of two that is at least n and at least 1.
+func nextPowerOfTwo(n int) int {
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
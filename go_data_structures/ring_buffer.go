@@ -0,0 +1,114 @@
+package go_data_structures
+
+// OverflowPolicy decides what a full RingBuffer does with a new item.
+type OverflowPolicy int
+
+const (
+	OverwriteOldest OverflowPolicy = iota // evict the oldest item to make room
+	DropNewest                            // keep the buffer as is and drop the new item
+)
+
+// RingBuffer is a fixed-capacity circular buffer, for keeping the most
+// recent items of a stream such as metrics or log lines. When it is full,
+// its OverflowPolicy decides which item is lost, and the overflow callback,
+// if any, is told about it.
+type RingBuffer[T any] struct {
+	ring       ring[T]
+	capacity   int
+	policy     OverflowPolicy
+	onOverflow func(val T)
+}
+
+// NewRingBuffer creates an empty buffer holding at most capacity items.
+// onOverflow, if not nil, is called with every item evicted or dropped
+// because the buffer was full.
+func NewRingBuffer[T any](capacity int, policy OverflowPolicy, onOverflow func(val T)) *RingBuffer[T] {
+	rb := &RingBuffer[T]{capacity: max(capacity, 1), policy: policy, onOverflow: onOverflow}
+	rb.ring.resize(nextPowerOfTwo(rb.capacity))
+	return rb
+}
+
+// Push adds val as the newest item, returning false if the buffer was full
+// and the policy dropped val. O(1)
+func (rb *RingBuffer[T]) Push(val T) bool {
+	if rb.ring.size == rb.capacity {
+		lost := val
+		if rb.policy == OverwriteOldest {
+			lost = rb.ring.popFront()
+		}
+		if rb.onOverflow != nil {
+			rb.onOverflow(lost)
+		}
+		if rb.policy != OverwriteOldest {
+			return false
+		}
+	}
+	rb.ring.pushBack(val)
+	return true
+}
+
+// Pop removes and returns the oldest item, and false if the buffer is empty. O(1)
+func (rb *RingBuffer[T]) Pop() (T, bool) {
+	if rb.ring.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return rb.ring.popFront(), true
+}
+
+// At returns the i-th oldest item, and false if i is out of range. O(1)
+func (rb *RingBuffer[T]) At(i int) (T, bool) {
+	if i < 0 || i >= rb.ring.size {
+		var zero T
+		return zero, false
+	}
+	return rb.ring.at(i), true
+}
+
+// All returns a function that calls yield on each item from oldest to
+// newest, stopping early if yield returns false. The buffer must not be
+// modified until it returns.
+func (rb *RingBuffer[T]) All() func(yield func(val T) bool) {
+	return func(yield func(val T) bool) {
+		for i := 0; i < rb.ring.size; i++ {
+			if !yield(rb.ring.at(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Snapshot returns a copy of the items from oldest to newest. O(n)
+func (rb *RingBuffer[T]) Snapshot() []T {
+	vals := make([]T, 0, rb.ring.size)
+	rb.All()(func(val T) bool {
+		vals = append(vals, val)
+		return true
+	})
+	return vals
+}
+
+// Len returns the number of items in the buffer. O(1)
+func (rb *RingBuffer[T]) Len() int {
+	return rb.ring.size
+}
+
+// Cap returns the most items the buffer holds. O(1)
+func (rb *RingBuffer[T]) Cap() int {
+	return rb.capacity
+}
+
+// Full returns whether the next Push will overflow. O(1)
+func (rb *RingBuffer[T]) Full() bool {
+	return rb.ring.size == rb.capacity
+}
+
+// Empty returns whether the buffer is empty. O(1)
+func (rb *RingBuffer[T]) Empty() bool {
+	return rb.ring.size == 0
+}
+
+// Clear removes every item without calling the overflow callback.
+func (rb *RingBuffer[T]) Clear() {
+	rb.ring.clear()
+}
@@ -0,0 +1,45 @@
+package go_data_structures
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRingBufferOverflow(t *testing.T) {
+	var evicted []int
+	rb := NewRingBuffer(3, OverwriteOldest, func(old int) { evicted = append(evicted, old) })
+	for i := 1; i <= 5; i++ {
+		if !rb.Push(i) {
+			t.Fatalf("Push(%d) = false with OverwriteOldest", i)
+		}
+	}
+	if got := rb.Snapshot(); !reflect.DeepEqual(got, []int{3, 4, 5}) || !reflect.DeepEqual(evicted, []int{1, 2}) {
+		t.Fatalf("Snapshot() = %v, evicted %v, want [3 4 5] and [1 2]", got, evicted)
+	}
+
+	var dropped []int
+	rb = NewRingBuffer(2, DropNewest, func(val int) { dropped = append(dropped, val) })
+	rb.Push(1)
+	rb.Push(2)
+	if rb.Push(3) || !rb.Full() || !reflect.DeepEqual(dropped, []int{3}) {
+		t.Fatalf("Push on a full DropNewest buffer kept the item")
+	}
+	if v, ok := rb.Pop(); !ok || v != 1 {
+		t.Fatalf("Pop() = %d, %v, want 1", v, ok)
+	}
+}
+
+func TestRingBufferAll(t *testing.T) {
+	rb := NewRingBuffer[int](4, OverwriteOldest, nil)
+	for i := 1; i <= 6; i++ {
+		rb.Push(i)
+	}
+	var got []int
+	rb.All()(func(val int) bool {
+		got = append(got, val)
+		return val < 5
+	})
+	if !reflect.DeepEqual(got, []int{3, 4, 5}) {
+		t.Fatalf("All() visited %v, want [3 4 5] stopping after 5", got)
+	}
+}
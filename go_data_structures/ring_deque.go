@@ -0,0 +1,131 @@
+package go_data_structures
+
+// minRingCapacity is the smallest buffer a RingDeque allocates or shrinks to.
+const minRingCapacity = 8
+
+// RingDeque is a double-ended queue over a growable circular buffer. Pushes
+// and pops at either end are amortized O(1) with no per-item allocation,
+// and the buffer halves once it is a quarter full, so a long-running queue
+// releases memory it no longer needs.
+type RingDeque[T any] struct {
+	ring ring[T]
+}
+
+var _ QueueInterface[int] = (*RingDeque[int])(nil)
+var _ DequeInterface[int] = (*RingDeque[int])(nil)
+
+func NewRingDeque[T any]() *RingDeque[T] {
+	return &RingDeque[T]{}
+}
+
+// NewRingDequeWithCapacity creates a RingDeque with room for capacity items before it grows.
+func NewRingDequeWithCapacity[T any](capacity int) *RingDeque[T] {
+	rd := &RingDeque[T]{}
+	rd.ring.resize(nextPowerOfTwo(max(capacity, minRingCapacity)))
+	return rd
+}
+
+func (rd *RingDeque[T]) grow() {
+	if rd.ring.size == len(rd.ring.buf) {
+		rd.ring.resize(max(2*len(rd.ring.buf), minRingCapacity))
+	}
+}
+
+// shrink halves the buffer once it is no more than a quarter full.
+func (rd *RingDeque[T]) shrink() {
+	if n := len(rd.ring.buf); n > minRingCapacity && rd.ring.size <= n/4 {
+		rd.ring.resize(n / 2)
+	}
+}
+
+// Front returns the first item, or the zero value if the deque is empty. O(1)
+func (rd *RingDeque[T]) Front() T {
+	if rd.ring.size == 0 {
+		var zero T
+		return zero
+	}
+	return rd.ring.at(0)
+}
+
+// Back returns the last item, or the zero value if the deque is empty. O(1)
+func (rd *RingDeque[T]) Back() T {
+	if rd.ring.size == 0 {
+		var zero T
+		return zero
+	}
+	return rd.ring.at(rd.ring.size - 1)
+}
+
+// At returns the i-th item from the front, and false if i is out of range. O(1)
+func (rd *RingDeque[T]) At(i int) (T, bool) {
+	if i < 0 || i >= rd.ring.size {
+		var zero T
+		return zero, false
+	}
+	return rd.ring.at(i), true
+}
+
+func (rd *RingDeque[T]) PushFront(val T) {
+	rd.grow()
+	rd.ring.pushFront(val)
+}
+
+func (rd *RingDeque[T]) PushBack(val T) {
+	rd.grow()
+	rd.ring.pushBack(val)
+}
+
+func (rd *RingDeque[T]) PopFront() (T, bool) {
+	if rd.ring.size == 0 {
+		var zero T
+		return zero, false
+	}
+	val := rd.ring.popFront()
+	rd.shrink()
+	return val, true
+}
+
+func (rd *RingDeque[T]) PopBack() (T, bool) {
+	if rd.ring.size == 0 {
+		var zero T
+		return zero, false
+	}
+	val := rd.ring.popBack()
+	rd.shrink()
+	return val, true
+}
+
+// Enqueue is PushBack, for use as a queue.
+func (rd *RingDeque[T]) Enqueue(val T) {
+	rd.PushBack(val)
+}
+
+// Dequeue is PopFront without the result, for use as a queue.
+func (rd *RingDeque[T]) Dequeue() {
+	rd.PopFront()
+}
+
+func (rd *RingDeque[T]) Empty() bool {
+	return rd.ring.size == 0
+}
+
+func (rd *RingDeque[T]) Size() int {
+	return rd.ring.size
+}
+
+// Cap returns the number of items the deque can hold before it grows.
+func (rd *RingDeque[T]) Cap() int {
+	return len(rd.ring.buf)
+}
+
+// Shrink reallocates the buffer to the smallest capacity that holds the current items.
+func (rd *RingDeque[T]) Shrink() {
+	if n := nextPowerOfTwo(max(rd.ring.size, minRingCapacity)); n < len(rd.ring.buf) {
+		rd.ring.resize(n)
+	}
+}
+
+// Clear removes every item, keeping the allocated capacity.
+func (rd *RingDeque[T]) Clear() {
+	rd.ring.clear()
+}
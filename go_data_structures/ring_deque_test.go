@@ -0,0 +1,182 @@
+package go_data_structures
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRingDequeMatchesSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(12))
+	rd := NewRingDeque[int]()
+	var model []int
+	for op := 0; op < 20000; op++ {
+		// Bias toward pushes for the first half and pops after, so the
+		// buffer both grows and shrinks.
+		push := r.Intn(10) < 6
+		if op >= 10000 {
+			push = !push
+		}
+		front := r.Intn(2) == 0
+		switch {
+		case push && front:
+			rd.PushFront(op)
+			model = append([]int{op}, model...)
+		case push:
+			rd.PushBack(op)
+			model = append(model, op)
+		case front:
+			got, ok := rd.PopFront()
+			if ok != (len(model) > 0) || (ok && got != model[0]) {
+				t.Fatalf("PopFront() = %d, %v", got, ok)
+			}
+			if ok {
+				model = model[1:]
+			}
+		default:
+			got, ok := rd.PopBack()
+			if ok != (len(model) > 0) || (ok && got != model[len(model)-1]) {
+				t.Fatalf("PopBack() = %d, %v", got, ok)
+			}
+			if ok {
+				model = model[:len(model)-1]
+			}
+		}
+		if rd.Size() != len(model) {
+			t.Fatalf("Size() = %d, want %d", rd.Size(), len(model))
+		}
+		if len(model) > 0 {
+			i := r.Intn(len(model))
+			if v, ok := rd.At(i); !ok || v != model[i] || rd.Front() != model[0] || rd.Back() != model[len(model)-1] {
+				t.Fatalf("At(%d) = %d, %v, want %d", i, v, ok, model[i])
+			}
+		}
+	}
+}
+
+const benchQueueSize = 10000
+
+// BenchmarkQueueGrow fills an empty queue from the back and drains it from
+// the front, so every buffer-backed queue goes through its growth path.
+func BenchmarkQueueGrow(b *testing.B) {
+	b.Run("RingDeque", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			q := NewRingDeque[int]()
+			for j := 0; j < benchQueueSize; j++ {
+				q.Enqueue(j)
+			}
+			for !q.Empty() {
+				q.Dequeue()
+			}
+		}
+	})
+	b.Run("RingDequePresized", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			q := NewRingDequeWithCapacity[int](benchQueueSize)
+			for j := 0; j < benchQueueSize; j++ {
+				q.Enqueue(j)
+			}
+			for !q.Empty() {
+				q.Dequeue()
+			}
+		}
+	})
+	b.Run("AlternateQueue", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			q := NewAlternateQueue[int]()
+			for j := 0; j < benchQueueSize; j++ {
+				q.Enqueue(j)
+			}
+			for !q.Empty() {
+				q.Dequeue()
+			}
+		}
+	})
+	b.Run("Deque", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			q := NewDeque[int]()
+			for j := 0; j < benchQueueSize; j++ {
+				q.PushBack(j)
+			}
+			for !q.Empty() {
+				q.PopFront()
+			}
+		}
+	})
+}
+
+// BenchmarkQueueSteady keeps a queue at a fixed length, pushing one item at
+// the back for each popped from the front.
+func BenchmarkQueueSteady(b *testing.B) {
+	b.Run("RingDeque", func(b *testing.B) {
+		q := NewRingDeque[int]()
+		for j := 0; j < benchQueueSize; j++ {
+			q.Enqueue(j)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			q.Enqueue(i)
+			q.Dequeue()
+		}
+	})
+	b.Run("AlternateQueue", func(b *testing.B) {
+		q := NewAlternateQueue[int]()
+		for j := 0; j < benchQueueSize; j++ {
+			q.Enqueue(j)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			q.Enqueue(i)
+			q.Dequeue()
+		}
+	})
+	b.Run("Deque", func(b *testing.B) {
+		q := NewDeque[int]()
+		for j := 0; j < benchQueueSize; j++ {
+			q.PushBack(j)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			q.PushBack(i)
+			q.PopFront()
+		}
+	})
+}
+
+// BenchmarkDequeEnds pushes and pops a batch at each end in turn.
+func BenchmarkDequeEnds(b *testing.B) {
+	const batch = 1000
+	b.Run("RingDeque", func(b *testing.B) {
+		dq := NewRingDeque[int]()
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < batch; j++ {
+				dq.PushFront(j)
+			}
+			for j := 0; j < batch; j++ {
+				dq.PopFront()
+			}
+			for j := 0; j < batch; j++ {
+				dq.PushBack(j)
+			}
+			for j := 0; j < batch; j++ {
+				dq.PopBack()
+			}
+		}
+	})
+	b.Run("Deque", func(b *testing.B) {
+		dq := NewDeque[int]()
+		for i := 0; i < b.N; i++ {
+			for j := 0; j < batch; j++ {
+				dq.PushFront(j)
+			}
+			for j := 0; j < batch; j++ {
+				dq.PopFront()
+			}
+			for j := 0; j < batch; j++ {
+				dq.PushBack(j)
+			}
+			for j := 0; j < batch; j++ {
+				dq.PopBack()
+			}
+		}
+	})
+}
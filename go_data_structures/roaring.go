@@ -0,0 +1,800 @@
+package go_data_structures
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"sort"
+)
+
+// Containers switch from a sorted array to a bitmap above this cardinality,
+// the point where the array's two bytes per value outgrow the 8 KiB bitmap.
+const arrayContainerMax = 4096
+
+const bitmapContainerWords = 1 << 16 / wordSize
+
+// Cookies from the Roaring serialization spec, shared with the C and Java implementations.
+const (
+	roaringCookieNoRuns = 12346
+	roaringCookieRuns   = 12347
+	roaringNoOffsetMax  = 4 // with runs, offsets are only written for this many containers or more
+)
+
+var errRoaringCorrupt = errors.New("roaring: corrupt or truncated input")
+
+// roaringContainer holds the low 16 bits of every value sharing one high 16-bit key.
+type roaringContainer interface {
+	contains(x uint16) bool
+	add(x uint16) roaringContainer    // adds x, returning the container to keep (it may change representation)
+	remove(x uint16) roaringContainer // removes x, returning the container to keep (it may change representation)
+	cardinality() int
+	rank(x uint16) int              // number of values <= x
+	selectAt(i int) uint16          // the i-th smallest value, 0-indexed
+	each(fn func(uint16) bool) bool // visits values in ascending order, returns false if fn stopped early
+	toBitmap() *bitmapContainer
+	clone() roaringContainer
+}
+
+// arrayContainer stores a sorted slice of values; used for sparse chunks.
+type arrayContainer struct {
+	values []uint16
+}
+
+func (c *arrayContainer) search(x uint16) int {
+	return sort.Search(len(c.values), func(i int) bool { return c.values[i] >= x })
+}
+
+func (c *arrayContainer) contains(x uint16) bool {
+	i := c.search(x)
+	return i < len(c.values) && c.values[i] == x
+}
+
+func (c *arrayContainer) add(x uint16) roaringContainer {
+	i := c.search(x)
+	if i < len(c.values) && c.values[i] == x {
+		return c
+	}
+	if len(c.values) == arrayContainerMax {
+		return c.toBitmap().add(x)
+	}
+	c.values = append(c.values, 0)
+	copy(c.values[i+1:], c.values[i:])
+	c.values[i] = x
+	return c
+}
+
+func (c *arrayContainer) remove(x uint16) roaringContainer {
+	i := c.search(x)
+	if i < len(c.values) && c.values[i] == x {
+		c.values = append(c.values[:i], c.values[i+1:]...)
+	}
+	return c
+}
+
+func (c *arrayContainer) cardinality() int      { return len(c.values) }
+func (c *arrayContainer) selectAt(i int) uint16 { return c.values[i] }
+
+func (c *arrayContainer) rank(x uint16) int {
+	i := c.search(x)
+	if i < len(c.values) && c.values[i] == x {
+		return i + 1
+	}
+	return i
+}
+
+func (c *arrayContainer) each(fn func(uint16) bool) bool {
+	for _, v := range c.values {
+		if !fn(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *arrayContainer) toBitmap() *bitmapContainer {
+	b := &bitmapContainer{}
+	for _, v := range c.values {
+		b.words[v/wordSize] |= 1 << (v % wordSize)
+	}
+	b.card = len(c.values)
+	return b
+}
+
+func (c *arrayContainer) clone() roaringContainer {
+	return &arrayContainer{values: append([]uint16(nil), c.values...)}
+}
+
+// bitmapContainer stores one bit per possible value; used for dense chunks.
+type bitmapContainer struct {
+	words [bitmapContainerWords]uint64
+	card  int
+}
+
+func (c *bitmapContainer) contains(x uint16) bool {
+	return c.words[x/wordSize]&(1<<(x%wordSize)) != 0
+}
+
+func (c *bitmapContainer) add(x uint16) roaringContainer {
+	if !c.contains(x) {
+		c.words[x/wordSize] |= 1 << (x % wordSize)
+		c.card++
+	}
+	return c
+}
+
+func (c *bitmapContainer) remove(x uint16) roaringContainer {
+	if !c.contains(x) {
+		return c
+	}
+	c.words[x/wordSize] &^= 1 << (x % wordSize)
+	c.card--
+	if c.card <= arrayContainerMax {
+		return c.toArray()
+	}
+	return c
+}
+
+func (c *bitmapContainer) cardinality() int { return c.card }
+
+func (c *bitmapContainer) rank(x uint16) int {
+	n := 0
+	w := int(x / wordSize)
+	for i := 0; i < w; i++ {
+		n += bits.OnesCount64(c.words[i])
+	}
+	// Keep bits 0..x%64 of the last word.
+	mask := ^uint64(0) >> (wordSize - 1 - x%wordSize)
+	return n + bits.OnesCount64(c.words[w]&mask)
+}
+
+func (c *bitmapContainer) selectAt(i int) uint16 {
+	for w, word := range c.words {
+		n := bits.OnesCount64(word)
+		if i < n {
+			for ; i > 0; i-- {
+				word &= word - 1
+			}
+			return uint16(w*wordSize + bits.TrailingZeros64(word))
+		}
+		i -= n
+	}
+	panic("roaring: select index out of range")
+}
+
+func (c *bitmapContainer) each(fn func(uint16) bool) bool {
+	for w, word := range c.words {
+		for word != 0 {
+			if !fn(uint16(w*wordSize + bits.TrailingZeros64(word))) {
+				return false
+			}
+			word &= word - 1
+		}
+	}
+	return true
+}
+
+func (c *bitmapContainer) toBitmap() *bitmapContainer { return c }
+
+func (c *bitmapContainer) toArray() *arrayContainer {
+	a := &arrayContainer{values: make([]uint16, 0, c.card)}
+	c.each(func(v uint16) bool {
+		a.values = append(a.values, v)
+		return true
+	})
+	return a
+}
+
+func (c *bitmapContainer) clone() roaringContainer {
+	clone := *c
+	return &clone
+}
+
+// normalize converts a bitmap that has become sparse back to an array.
+func (c *bitmapContainer) normalize() roaringContainer {
+	c.card = 0
+	for _, word := range c.words {
+		c.card += bits.OnesCount64(word)
+	}
+	if c.card <= arrayContainerMax {
+		return c.toArray()
+	}
+	return c
+}
+
+// roaringRun is a run of length+1 consecutive values starting at start,
+// matching the serialized layout.
+type roaringRun struct {
+	start  uint16
+	length uint16
+}
+
+// runContainer stores sorted, non-overlapping runs; used for clustered chunks.
+// It is produced by RunOptimize and reverts to an array or bitmap on mutation.
+type runContainer struct {
+	runs []roaringRun
+}
+
+func (c *runContainer) contains(x uint16) bool {
+	i := sort.Search(len(c.runs), func(i int) bool { return c.runs[i].start > x }) - 1
+	return i >= 0 && uint32(x) <= uint32(c.runs[i].start)+uint32(c.runs[i].length)
+}
+
+func (c *runContainer) add(x uint16) roaringContainer {
+	if c.contains(x) {
+		return c
+	}
+	return c.toArrayOrBitmap().add(x)
+}
+
+func (c *runContainer) remove(x uint16) roaringContainer {
+	if !c.contains(x) {
+		return c
+	}
+	return c.toArrayOrBitmap().remove(x)
+}
+
+func (c *runContainer) cardinality() int {
+	n := 0
+	for _, r := range c.runs {
+		n += int(r.length) + 1
+	}
+	return n
+}
+
+func (c *runContainer) rank(x uint16) int {
+	n := 0
+	for _, r := range c.runs {
+		if x < r.start {
+			break
+		}
+		if uint32(x) <= uint32(r.start)+uint32(r.length) {
+			return n + int(x-r.start) + 1
+		}
+		n += int(r.length) + 1
+	}
+	return n
+}
+
+func (c *runContainer) selectAt(i int) uint16 {
+	for _, r := range c.runs {
+		if i <= int(r.length) {
+			return r.start + uint16(i)
+		}
+		i -= int(r.length) + 1
+	}
+	panic("roaring: select index out of range")
+}
+
+func (c *runContainer) each(fn func(uint16) bool) bool {
+	for _, r := range c.runs {
+		for v := uint32(r.start); v <= uint32(r.start)+uint32(r.length); v++ {
+			if !fn(uint16(v)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (c *runContainer) toBitmap() *bitmapContainer {
+	b := &bitmapContainer{}
+	c.each(func(v uint16) bool {
+		b.words[v/wordSize] |= 1 << (v % wordSize)
+		return true
+	})
+	b.card = c.cardinality()
+	return b
+}
+
+func (c *runContainer) toArrayOrBitmap() roaringContainer {
+	if c.cardinality() > arrayContainerMax {
+		return c.toBitmap()
+	}
+	a := &arrayContainer{values: make([]uint16, 0, c.cardinality())}
+	c.each(func(v uint16) bool {
+		a.values = append(a.values, v)
+		return true
+	})
+	return a
+}
+
+func (c *runContainer) clone() roaringContainer {
+	return &runContainer{runs: append([]roaringRun(nil), c.runs...)}
+}
+
+// runOptimize returns whichever representation of c serializes smallest.
+func runOptimize(c roaringContainer) roaringContainer {
+	var runs []roaringRun
+	c.each(func(v uint16) bool {
+		if n := len(runs); n > 0 && uint32(runs[n-1].start)+uint32(runs[n-1].length)+1 == uint32(v) {
+			runs[n-1].length++
+		} else {
+			runs = append(runs, roaringRun{start: v})
+		}
+		return true
+	})
+	card := c.cardinality()
+	runSize := 2 + 4*len(runs)
+	plainSize := 2 * card
+	if card > arrayContainerMax {
+		plainSize = 8 * bitmapContainerWords
+	}
+	if runSize < plainSize {
+		return &runContainer{runs: runs}
+	}
+	if rc, ok := c.(*runContainer); ok {
+		return rc.toArrayOrBitmap()
+	}
+	return c
+}
+
+func unionContainers(a, b roaringContainer) roaringContainer {
+	if x, ok := a.(*arrayContainer); ok {
+		if y, ok := b.(*arrayContainer); ok && len(x.values)+len(y.values) <= arrayContainerMax {
+			merged := make([]uint16, 0, len(x.values)+len(y.values))
+			i, j := 0, 0
+			for i < len(x.values) && j < len(y.values) {
+				switch {
+				case x.values[i] < y.values[j]:
+					merged = append(merged, x.values[i])
+					i++
+				case x.values[i] > y.values[j]:
+					merged = append(merged, y.values[j])
+					j++
+				default:
+					merged = append(merged, x.values[i])
+					i++
+					j++
+				}
+			}
+			merged = append(merged, x.values[i:]...)
+			merged = append(merged, y.values[j:]...)
+			return &arrayContainer{values: merged}
+		}
+	}
+	result := a.toBitmap()
+	if result == a {
+		result = a.clone().(*bitmapContainer)
+	}
+	other := b.toBitmap()
+	for i := range result.words {
+		result.words[i] |= other.words[i]
+	}
+	return result.normalize()
+}
+
+func intersectContainers(a, b roaringContainer) roaringContainer {
+	if _, ok := b.(*arrayContainer); ok {
+		a, b = b, a
+	}
+	if x, ok := a.(*arrayContainer); ok {
+		result := &arrayContainer{}
+		for _, v := range x.values {
+			if b.contains(v) {
+				result.values = append(result.values, v)
+			}
+		}
+		return result
+	}
+	result := a.toBitmap()
+	if result == a {
+		result = a.clone().(*bitmapContainer)
+	}
+	other := b.toBitmap()
+	for i := range result.words {
+		result.words[i] &= other.words[i]
+	}
+	return result.normalize()
+}
+
+func differenceContainers(a, b roaringContainer) roaringContainer {
+	if x, ok := a.(*arrayContainer); ok {
+		result := &arrayContainer{}
+		for _, v := range x.values {
+			if !b.contains(v) {
+				result.values = append(result.values, v)
+			}
+		}
+		return result
+	}
+	result := a.toBitmap()
+	if result == a {
+		result = a.clone().(*bitmapContainer)
+	}
+	other := b.toBitmap()
+	for i := range result.words {
+		result.words[i] &^= other.words[i]
+	}
+	return result.normalize()
+}
+
+// RoaringBitmap is a compressed set of uint32 values. Values are split by
+// their high 16 bits into chunks, and each chunk is stored as a sorted array,
+// a bitmap or a list of runs depending on which is smallest.
+type RoaringBitmap struct {
+	keys       []uint16
+	containers []roaringContainer
+}
+
+var _ SetInterface[uint32] = (*RoaringBitmap)(nil)
+
+// NewRoaringBitmap creates a new, empty RoaringBitmap.
+func NewRoaringBitmap() *RoaringBitmap {
+	return &RoaringBitmap{}
+}
+
+// RoaringBitmapFromSlice creates a RoaringBitmap holding the given values.
+func RoaringBitmapFromSlice(vals []uint32) *RoaringBitmap {
+	rb := NewRoaringBitmap()
+	for _, v := range vals {
+		rb.Add(v)
+	}
+	return rb
+}
+
+// index returns the position of key in rb.keys, or where it would be inserted.
+func (rb *RoaringBitmap) index(key uint16) (int, bool) {
+	i := sort.Search(len(rb.keys), func(i int) bool { return rb.keys[i] >= key })
+	return i, i < len(rb.keys) && rb.keys[i] == key
+}
+
+// Add inserts x into the bitmap.
+func (rb *RoaringBitmap) Add(x uint32) {
+	key, low := uint16(x>>16), uint16(x)
+	i, found := rb.index(key)
+	if found {
+		rb.containers[i] = rb.containers[i].add(low)
+		return
+	}
+	rb.keys = append(rb.keys, 0)
+	copy(rb.keys[i+1:], rb.keys[i:])
+	rb.keys[i] = key
+	rb.containers = append(rb.containers, nil)
+	copy(rb.containers[i+1:], rb.containers[i:])
+	rb.containers[i] = &arrayContainer{values: []uint16{low}}
+}
+
+// Remove deletes x from the bitmap if present.
+func (rb *RoaringBitmap) Remove(x uint32) {
+	i, found := rb.index(uint16(x >> 16))
+	if !found {
+		return
+	}
+	rb.containers[i] = rb.containers[i].remove(uint16(x))
+	if rb.containers[i].cardinality() == 0 {
+		rb.keys = append(rb.keys[:i], rb.keys[i+1:]...)
+		rb.containers = append(rb.containers[:i], rb.containers[i+1:]...)
+	}
+}
+
+// Contains returns whether x is in the bitmap.
+func (rb *RoaringBitmap) Contains(x uint32) bool {
+	i, found := rb.index(uint16(x >> 16))
+	return found && rb.containers[i].contains(uint16(x))
+}
+
+// Insert adds x to the bitmap; it is Add under the SetInterface name.
+func (rb *RoaringBitmap) Insert(x uint32) {
+	rb.Add(x)
+}
+
+// Cardinality returns the number of values in the bitmap. O(number of chunks)
+func (rb *RoaringBitmap) Cardinality() int {
+	n := 0
+	for _, c := range rb.containers {
+		n += c.cardinality()
+	}
+	return n
+}
+
+// Size returns the number of values in the bitmap.
+func (rb *RoaringBitmap) Size() int {
+	return rb.Cardinality()
+}
+
+// Empty returns whether the bitmap holds no values.
+func (rb *RoaringBitmap) Empty() bool {
+	return len(rb.containers) == 0
+}
+
+// Rank returns the number of values less than or equal to x.
+func (rb *RoaringBitmap) Rank(x uint32) int {
+	key := uint16(x >> 16)
+	n := 0
+	for i, k := range rb.keys {
+		if k > key {
+			break
+		}
+		if k == key {
+			return n + rb.containers[i].rank(uint16(x))
+		}
+		n += rb.containers[i].cardinality()
+	}
+	return n
+}
+
+// Select returns the i-th smallest value (0-indexed), and false if i is out of range.
+func (rb *RoaringBitmap) Select(i int) (uint32, bool) {
+	if i < 0 {
+		return 0, false
+	}
+	for j, c := range rb.containers {
+		if n := c.cardinality(); i >= n {
+			i -= n
+			continue
+		}
+		return uint32(rb.keys[j])<<16 | uint32(c.selectAt(i)), true
+	}
+	return 0, false
+}
+
+// Each calls fn for every value in ascending order until fn returns false.
+func (rb *RoaringBitmap) Each(fn func(x uint32) bool) {
+	for i, c := range rb.containers {
+		high := uint32(rb.keys[i]) << 16
+		if !c.each(func(low uint16) bool { return fn(high | uint32(low)) }) {
+			return
+		}
+	}
+}
+
+// Values returns all values in ascending order.
+func (rb *RoaringBitmap) Values() []uint32 {
+	values := make([]uint32, 0, rb.Cardinality())
+	rb.Each(func(x uint32) bool {
+		values = append(values, x)
+		return true
+	})
+	return values
+}
+
+// Clone returns a deep copy of the bitmap.
+func (rb *RoaringBitmap) Clone() *RoaringBitmap {
+	clone := &RoaringBitmap{
+		keys:       append([]uint16(nil), rb.keys...),
+		containers: make([]roaringContainer, len(rb.containers)),
+	}
+	for i, c := range rb.containers {
+		clone.containers[i] = c.clone()
+	}
+	return clone
+}
+
+// Union returns a new bitmap with the values of both bitmaps.
+func (rb *RoaringBitmap) Union(other *RoaringBitmap) *RoaringBitmap {
+	result := &RoaringBitmap{}
+	i, j := 0, 0
+	for i < len(rb.keys) || j < len(other.keys) {
+		switch {
+		case j == len(other.keys) || (i < len(rb.keys) && rb.keys[i] < other.keys[j]):
+			result.keys = append(result.keys, rb.keys[i])
+			result.containers = append(result.containers, rb.containers[i].clone())
+			i++
+		case i == len(rb.keys) || other.keys[j] < rb.keys[i]:
+			result.keys = append(result.keys, other.keys[j])
+			result.containers = append(result.containers, other.containers[j].clone())
+			j++
+		default:
+			result.keys = append(result.keys, rb.keys[i])
+			result.containers = append(result.containers, unionContainers(rb.containers[i], other.containers[j]))
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+// Intersection returns a new bitmap with the values present in both bitmaps.
+func (rb *RoaringBitmap) Intersection(other *RoaringBitmap) *RoaringBitmap {
+	result := &RoaringBitmap{}
+	i, j := 0, 0
+	for i < len(rb.keys) && j < len(other.keys) {
+		switch {
+		case rb.keys[i] < other.keys[j]:
+			i++
+		case rb.keys[i] > other.keys[j]:
+			j++
+		default:
+			if c := intersectContainers(rb.containers[i], other.containers[j]); c.cardinality() > 0 {
+				result.keys = append(result.keys, rb.keys[i])
+				result.containers = append(result.containers, c)
+			}
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+// Difference returns a new bitmap with the values of rb that are not in other.
+func (rb *RoaringBitmap) Difference(other *RoaringBitmap) *RoaringBitmap {
+	result := &RoaringBitmap{}
+	j := 0
+	for i, key := range rb.keys {
+		for j < len(other.keys) && other.keys[j] < key {
+			j++
+		}
+		var c roaringContainer
+		if j < len(other.keys) && other.keys[j] == key {
+			c = differenceContainers(rb.containers[i], other.containers[j])
+		} else {
+			c = rb.containers[i].clone()
+		}
+		if c.cardinality() > 0 {
+			result.keys = append(result.keys, key)
+			result.containers = append(result.containers, c)
+		}
+	}
+	return result
+}
+
+// RunOptimize converts each chunk to run encoding where that is smaller.
+// Call it after bulk loading clustered values, before serializing.
+func (rb *RoaringBitmap) RunOptimize() {
+	for i, c := range rb.containers {
+		rb.containers[i] = runOptimize(c)
+	}
+}
+
+// MarshalBinary encodes the bitmap in the portable Roaring format, readable
+// by the other Roaring implementations.
+func (rb *RoaringBitmap) MarshalBinary() ([]byte, error) {
+	n := len(rb.containers)
+	hasRuns := false
+	for _, c := range rb.containers {
+		if _, ok := c.(*runContainer); ok {
+			hasRuns = true
+		}
+	}
+
+	var buf []byte
+	le := binary.LittleEndian
+	writeOffsets := true
+	if hasRuns {
+		buf = le.AppendUint32(buf, uint32(roaringCookieRuns)|uint32(n-1)<<16)
+		runFlags := make([]byte, (n+7)/8)
+		for i, c := range rb.containers {
+			if _, ok := c.(*runContainer); ok {
+				runFlags[i/8] |= 1 << (i % 8)
+			}
+		}
+		buf = append(buf, runFlags...)
+		writeOffsets = n >= roaringNoOffsetMax
+	} else {
+		buf = le.AppendUint32(buf, roaringCookieNoRuns)
+		buf = le.AppendUint32(buf, uint32(n))
+	}
+	for i, c := range rb.containers {
+		buf = le.AppendUint16(buf, rb.keys[i])
+		buf = le.AppendUint16(buf, uint16(c.cardinality()-1))
+	}
+
+	offsetsAt := len(buf)
+	if writeOffsets {
+		buf = append(buf, make([]byte, 4*n)...)
+	}
+	for i, c := range rb.containers {
+		if writeOffsets {
+			le.PutUint32(buf[offsetsAt+4*i:], uint32(len(buf)))
+		}
+		switch c := c.(type) {
+		case *runContainer:
+			buf = le.AppendUint16(buf, uint16(len(c.runs)))
+			for _, r := range c.runs {
+				buf = le.AppendUint16(buf, r.start)
+				buf = le.AppendUint16(buf, r.length)
+			}
+		case *bitmapContainer:
+			for _, word := range c.words {
+				buf = le.AppendUint64(buf, word)
+			}
+		case *arrayContainer:
+			for _, v := range c.values {
+				buf = le.AppendUint16(buf, v)
+			}
+		}
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary replaces the bitmap's contents with data in the portable Roaring format.
+func (rb *RoaringBitmap) UnmarshalBinary(data []byte) error {
+	le := binary.LittleEndian
+	if len(data) < 4 {
+		return errRoaringCorrupt
+	}
+	cookie := le.Uint32(data)
+	pos := 4
+	var n int
+	var runFlags []byte
+	skipOffsets := false
+	switch {
+	case cookie == roaringCookieNoRuns:
+		if len(data) < 8 {
+			return errRoaringCorrupt
+		}
+		n = int(le.Uint32(data[4:]))
+		pos = 8
+	case cookie&0xFFFF == roaringCookieRuns:
+		n = int(cookie>>16) + 1
+		if len(data) < pos+(n+7)/8 {
+			return errRoaringCorrupt
+		}
+		runFlags = data[pos : pos+(n+7)/8]
+		pos += (n + 7) / 8
+		skipOffsets = n < roaringNoOffsetMax
+	default:
+		return errors.New("roaring: unknown cookie")
+	}
+	if n > 1<<16 || len(data) < pos+4*n {
+		return errRoaringCorrupt
+	}
+
+	keys := make([]uint16, n)
+	cards := make([]int, n)
+	for i := 0; i < n; i++ {
+		keys[i] = le.Uint16(data[pos:])
+		cards[i] = int(le.Uint16(data[pos+2:])) + 1
+		pos += 4
+		if i > 0 && keys[i] <= keys[i-1] {
+			return errRoaringCorrupt
+		}
+	}
+	if runFlags == nil || !skipOffsets {
+		// The offset header is redundant with the sizes we compute below.
+		pos += 4 * n
+	}
+
+	containers := make([]roaringContainer, n)
+	for i := 0; i < n; i++ {
+		switch {
+		case runFlags != nil && runFlags[i/8]&(1<<(i%8)) != 0:
+			if len(data) < pos+2 {
+				return errRoaringCorrupt
+			}
+			nruns := int(le.Uint16(data[pos:]))
+			pos += 2
+			if len(data) < pos+4*nruns {
+				return errRoaringCorrupt
+			}
+			runs := make([]roaringRun, nruns)
+			for j := range runs {
+				runs[j] = roaringRun{start: le.Uint16(data[pos:]), length: le.Uint16(data[pos+2:])}
+				pos += 4
+				end := uint32(runs[j].start) + uint32(runs[j].length)
+				if end > 0xFFFF || (j > 0 && uint32(runs[j].start) <= uint32(runs[j-1].start)+uint32(runs[j-1].length)+1) {
+					return errRoaringCorrupt
+				}
+			}
+			containers[i] = &runContainer{runs: runs}
+		case cards[i] > arrayContainerMax:
+			if len(data) < pos+8*bitmapContainerWords {
+				return errRoaringCorrupt
+			}
+			b := &bitmapContainer{}
+			for j := range b.words {
+				b.words[j] = le.Uint64(data[pos:])
+				pos += 8
+			}
+			containers[i] = b.normalize()
+		default:
+			if len(data) < pos+2*cards[i] {
+				return errRoaringCorrupt
+			}
+			values := make([]uint16, cards[i])
+			for j := range values {
+				values[j] = le.Uint16(data[pos:])
+				pos += 2
+				if j > 0 && values[j] <= values[j-1] {
+					return errRoaringCorrupt
+				}
+			}
+			containers[i] = &arrayContainer{values: values}
+		}
+		if containers[i].cardinality() != cards[i] {
+			return errRoaringCorrupt
+		}
+	}
+	rb.keys, rb.containers = keys, containers
+	return nil
+}
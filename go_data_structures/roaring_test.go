@@ -0,0 +1,188 @@
+package go_data_structures
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// sortedSetValues returns the values of s in ascending order, never nil.
+func sortedSetValues(s *Set[uint32]) []uint32 {
+	vals := append([]uint32{}, s.Values()...)
+	sort.Slice(vals, func(i, j int) bool { return vals[i] < vals[j] })
+	return vals
+}
+
+// randomRoaring builds a bitmap and a Set with the same random values. The
+// mode picks the shape: scattered values, dense chunks that cross the
+// array/bitmap threshold, or clustered runs.
+func randomRoaring(r *rand.Rand, mode int) (*RoaringBitmap, *Set[uint32]) {
+	rb, s := NewRoaringBitmap(), NewSet[uint32]()
+	n := r.Intn(3 * arrayContainerMax)
+	for i := 0; i < n; i++ {
+		var x uint32
+		switch mode {
+		case 0:
+			x = r.Uint32()
+		case 1:
+			x = uint32(r.Intn(3))<<16 | uint32(r.Intn(1<<16))
+		default:
+			x = uint32(r.Intn(2))<<16 | uint32(r.Intn(20)*1000+r.Intn(300))
+		}
+		rb.Add(x)
+		s.Insert(x)
+		if r.Intn(5) == 0 {
+			y := uint32(r.Intn(3))<<16 | uint32(r.Intn(1<<16))
+			rb.Remove(y)
+			s.Remove(y)
+		}
+	}
+	if r.Intn(2) == 0 {
+		rb.RunOptimize()
+	}
+	return rb, s
+}
+
+func TestRoaringBitmapMatchesSet(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 30; iter++ {
+		a, sa := randomRoaring(r, iter%3)
+		b, sb := randomRoaring(r, r.Intn(3))
+
+		vals := a.Values()
+		if !reflect.DeepEqual(vals, sortedSetValues(sa)) || a.Size() != sa.Size() {
+			t.Fatalf("iter %d: values differ from Set", iter)
+		}
+		if got, want := a.Union(b).Values(), sortedSetValues(sa.Union(sb)); !reflect.DeepEqual(got, want) {
+			t.Fatalf("iter %d: Union has %d values, want %d", iter, len(got), len(want))
+		}
+		if got, want := a.Intersection(b).Values(), sortedSetValues(sa.Intersection(sb)); !reflect.DeepEqual(got, want) {
+			t.Fatalf("iter %d: Intersection has %d values, want %d", iter, len(got), len(want))
+		}
+		if got, want := a.Difference(b).Values(), sortedSetValues(sa.Difference(sb)); !reflect.DeepEqual(got, want) {
+			t.Fatalf("iter %d: Difference has %d values, want %d", iter, len(got), len(want))
+		}
+
+		for i, v := range vals {
+			if a.Rank(v) != i+1 {
+				t.Fatalf("Rank(%d) = %d, want %d", v, a.Rank(v), i+1)
+			}
+			if x, ok := a.Select(i); !ok || x != v {
+				t.Fatalf("Select(%d) = %d, %v, want %d", i, x, ok, v)
+			}
+		}
+		if _, ok := a.Select(len(vals)); ok {
+			t.Fatalf("Select(%d) past the end succeeded", len(vals))
+		}
+		for k := 0; k < 200; k++ {
+			q := r.Uint32() >> uint(r.Intn(32))
+			if want := sort.Search(len(vals), func(i int) bool { return vals[i] > q }); a.Rank(q) != want {
+				t.Fatalf("Rank(%d) = %d, want %d", q, a.Rank(q), want)
+			}
+			if a.Contains(q) != sa.Contains(q) {
+				t.Fatalf("Contains(%d) = %v, Set says %v", q, a.Contains(q), sa.Contains(q))
+			}
+		}
+
+		data, err := a.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded := NewRoaringBitmap()
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("iter %d: UnmarshalBinary: %v", iter, err)
+		}
+		if !reflect.DeepEqual(decoded.Values(), vals) {
+			t.Fatalf("iter %d: round trip changed the values", iter)
+		}
+		for cut := 0; cut < len(data); cut += 1 + len(data)/20 {
+			if decoded.UnmarshalBinary(data[:cut]) == nil {
+				t.Fatalf("iter %d: UnmarshalBinary accepted %d of %d bytes", iter, cut, len(data))
+			}
+		}
+	}
+}
+
+func TestRoaringContainerTransitions(t *testing.T) {
+	containerKind := func(rb *RoaringBitmap) string {
+		switch rb.containers[0].(type) {
+		case *arrayContainer:
+			return "array"
+		case *bitmapContainer:
+			return "bitmap"
+		default:
+			return "run"
+		}
+	}
+	rb, s := NewRoaringBitmap(), NewSet[uint32]()
+	check := func(want string) {
+		t.Helper()
+		if got := containerKind(rb); got != want {
+			t.Fatalf("with %d values the container is %s, want %s", rb.Size(), got, want)
+		}
+		if !reflect.DeepEqual(rb.Values(), sortedSetValues(s)) {
+			t.Fatalf("with %d values the bitmap differs from Set", rb.Size())
+		}
+		data, _ := rb.MarshalBinary()
+		decoded := NewRoaringBitmap()
+		if err := decoded.UnmarshalBinary(data); err != nil || !reflect.DeepEqual(decoded.Values(), rb.Values()) {
+			t.Fatalf("round trip of a %s container failed: %v", want, err)
+		}
+	}
+
+	// Every other value, so runs never pay off.
+	for i := 0; i < arrayContainerMax; i++ {
+		rb.Add(uint32(2 * i))
+		s.Insert(uint32(2 * i))
+	}
+	check("array")
+	rb.Add(2 * arrayContainerMax)
+	s.Insert(2 * arrayContainerMax)
+	check("bitmap")
+	rb.Remove(0)
+	s.Remove(0)
+	check("array")
+
+	// Fill the gaps to make one long run.
+	for i := 0; i < 3*arrayContainerMax; i++ {
+		rb.Add(uint32(i))
+		s.Insert(uint32(i))
+	}
+	check("bitmap")
+	rb.RunOptimize()
+	check("run")
+	// Mutating a run container decodes it; RunOptimize encodes it again.
+	rb.Remove(100)
+	s.Remove(100)
+	check("bitmap")
+	rb.Add(3*arrayContainerMax + 5)
+	s.Insert(3*arrayContainerMax + 5)
+	rb.RunOptimize()
+	check("run")
+
+	// Shrink back below the threshold through Difference.
+	cut := NewRoaringBitmap()
+	for i := 0; i < 3*arrayContainerMax-10; i++ {
+		cut.Add(uint32(i))
+	}
+	rb = rb.Difference(cut)
+	s = s.Difference(func() *Set[uint32] {
+		cs := NewSet[uint32]()
+		cut.Each(func(x uint32) bool { cs.Insert(x); return true })
+		return cs
+	}())
+	check("array")
+}
+
+func TestRoaringPortableEncoding(t *testing.T) {
+	// {1, 2, 3} as one array container, per the Roaring format spec.
+	data, err := RoaringBitmapFromSlice([]uint32{1, 2, 3}).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0x3a, 0x30, 0, 0, 1, 0, 0, 0, 0, 0, 2, 0, 16, 0, 0, 0, 1, 0, 2, 0, 3, 0}
+	if !reflect.DeepEqual(data, want) {
+		t.Fatalf("MarshalBinary = %v, want %v", data, want)
+	}
+}
@@ -0,0 +1,164 @@
+package go_data_structures
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// Number is any integer or floating-point type.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// RunningMedian tracks the median of a stream with two heaps: a max-heap of
+// the lower half and a min-heap of the upper half, the lower half holding
+// the extra item when the count is odd. Removed values are deleted lazily,
+// once they reach the top of their heap.
+//
+// With a window, adding beyond it first removes the oldest value added, so
+// Median covers the last window values. The window decides what is removed,
+// so Remove always returns false.
+type RunningMedian[T Number] struct {
+	low, high         *Heap[T]
+	lowSize, highSize int       // live items in each half
+	counts            map[T]int // live occurrences of each value
+	removed           map[T]int // occurrences still in a heap but already removed
+	// recent holds the values in the window, as a circular buffer of
+	// window slots once full; nil without a window.
+	recent []T
+	next   int // slot of the oldest value in recent, overwritten next
+}
+
+// NewRunningMedian creates a RunningMedian over the whole stream.
+func NewRunningMedian[T Number]() *RunningMedian[T] {
+	return NewWindowedMedian[T](0)
+}
+
+// NewWindowedMedian creates a RunningMedian over the last window values
+// added; window 0 means the whole stream.
+func NewWindowedMedian[T Number](window int) *RunningMedian[T] {
+	rm := &RunningMedian[T]{
+		low:     NewHeap(func(a, b T) bool { return a > b }),
+		high:    NewMinHeap[T](),
+		counts:  make(map[T]int),
+		removed: make(map[T]int),
+	}
+	if window > 0 {
+		rm.recent = make([]T, 0, window)
+	}
+	return rm
+}
+
+// Add adds val to the stream. O(log n)
+func (rm *RunningMedian[T]) Add(val T) {
+	if rm.recent != nil {
+		if len(rm.recent) < cap(rm.recent) {
+			rm.recent = append(rm.recent, val)
+		} else {
+			// The window is full: evict the oldest value in favor of val.
+			rm.remove(rm.recent[rm.next])
+			rm.recent[rm.next] = val
+			rm.next = (rm.next + 1) % len(rm.recent)
+		}
+	}
+	rm.counts[val]++
+	if top, ok := rm.low.Peek(); !ok || val <= top {
+		rm.low.Push(val)
+		rm.lowSize++
+	} else {
+		rm.high.Push(val)
+		rm.highSize++
+	}
+	rm.balance()
+}
+
+// Remove removes one occurrence of val, returning false if there is none or
+// if the median is windowed. Amortized O(log n)
+func (rm *RunningMedian[T]) Remove(val T) bool {
+	if rm.recent != nil {
+		return false
+	}
+	return rm.remove(val)
+}
+
+// remove removes one occurrence of val, returning false if there is none.
+func (rm *RunningMedian[T]) remove(val T) bool {
+	if rm.counts[val] == 0 {
+		return false
+	}
+	if rm.counts[val]--; rm.counts[val] == 0 {
+		delete(rm.counts, val)
+	}
+	rm.removed[val]++
+	// Both tops are always live, so val belongs to the lower half exactly
+	// when it is at most the lower top. If it equals that top it may also
+	// sit in the upper half, so prune the lower half now to consume the mark.
+	if top, _ := rm.low.Peek(); val <= top {
+		rm.lowSize--
+		if val == top {
+			rm.prune(rm.low)
+		}
+	} else {
+		rm.highSize--
+		if top, _ := rm.high.Peek(); val == top {
+			rm.prune(rm.high)
+		}
+	}
+	rm.balance()
+	return true
+}
+
+// prune pops removed values off the top of h.
+func (rm *RunningMedian[T]) prune(h *Heap[T]) {
+	for {
+		top, ok := h.Peek()
+		if !ok || rm.removed[top] == 0 {
+			return
+		}
+		if rm.removed[top]--; rm.removed[top] == 0 {
+			delete(rm.removed, top)
+		}
+		h.Pop()
+	}
+}
+
+// balance moves the top of one half to the other until the lower half has
+// as many items as the upper half, or one more.
+func (rm *RunningMedian[T]) balance() {
+	if rm.lowSize > rm.highSize+1 {
+		top, _ := rm.low.Pop()
+		rm.high.Push(top)
+		rm.lowSize--
+		rm.highSize++
+		rm.prune(rm.low)
+	} else if rm.lowSize < rm.highSize {
+		top, _ := rm.high.Pop()
+		rm.low.Push(top)
+		rm.highSize--
+		rm.lowSize++
+		rm.prune(rm.high)
+	}
+}
+
+// Median returns the median, the mean of the two middle values when the
+// count is even, and false if there are no values. O(1)
+func (rm *RunningMedian[T]) Median() (float64, bool) {
+	if rm.lowSize == 0 {
+		return 0, false
+	}
+	lowTop, _ := rm.low.Peek()
+	if rm.lowSize > rm.highSize {
+		return float64(lowTop), true
+	}
+	highTop, _ := rm.high.Peek()
+	return (float64(lowTop) + float64(highTop)) / 2, true
+}
+
+// Empty returns whether there are no values. O(1)
+func (rm *RunningMedian[T]) Empty() bool {
+	return rm.lowSize == 0
+}
+
+// Size returns the number of values. O(1)
+func (rm *RunningMedian[T]) Size() int {
+	return rm.lowSize + rm.highSize
+}
@@ -0,0 +1,75 @@
+package go_data_structures
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// sortedMedian returns the median of s by sorting a copy.
+func sortedMedian(s []int) float64 {
+	sorted := append([]int(nil), s...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return float64(sorted[mid])
+	}
+	return float64(sorted[mid-1]+sorted[mid]) / 2
+}
+
+func TestRunningMedianMatchesSort(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	for it := 0; it < 50; it++ {
+		rm := NewRunningMedian[int]()
+		var live []int
+		for op := 0; op < 400; op++ {
+			if len(live) > 0 && r.Intn(3) == 0 {
+				i := r.Intn(len(live))
+				if !rm.Remove(live[i]) {
+					t.Fatalf("Remove(%d) = false", live[i])
+				}
+				live = append(live[:i], live[i+1:]...)
+			} else {
+				v := r.Intn(50)
+				rm.Add(v)
+				live = append(live, v)
+			}
+			got, ok := rm.Median()
+			if ok != (len(live) > 0) || rm.Size() != len(live) {
+				t.Fatalf("Median ok %v, Size %d with %d values", ok, rm.Size(), len(live))
+			}
+			if ok && got != sortedMedian(live) {
+				t.Fatalf("Median() = %v, want %v for %v", got, sortedMedian(live), live)
+			}
+		}
+		if rm.Remove(1000) {
+			t.Fatalf("Remove of an absent value succeeded")
+		}
+	}
+}
+
+func TestWindowedMedianMatchesSort(t *testing.T) {
+	r := rand.New(rand.NewSource(8))
+	for _, window := range []int{1, 2, 5, 16, 101} {
+		rm := NewWindowedMedian[int](window)
+		var stream []int
+		for i := 0; i < 1000; i++ {
+			v := r.Intn(30)
+			rm.Add(v)
+			stream = append(stream, v)
+			recent := stream[max(len(stream)-window, 0):]
+			got, ok := rm.Median()
+			if !ok || rm.Size() != len(recent) || got != sortedMedian(recent) {
+				t.Fatalf("window %d, step %d: Median() = %v, %v, Size %d, want %v over %d",
+					window, i, got, ok, rm.Size(), sortedMedian(recent), len(recent))
+			}
+			// The window alone decides what leaves it.
+			if rm.Remove(v) || rm.Size() != len(recent) {
+				t.Fatalf("window %d: Remove(%d) on a windowed median succeeded", window, v)
+			}
+		}
+		if cap(rm.recent) != window {
+			t.Fatalf("window %d: buffer grew to capacity %d", window, cap(rm.recent))
+		}
+	}
+}
@@ -1,14 +1,10 @@
 package go_data_structures
 
 import (
-	"encoding/json"
 	"golang.org/x/exp/constraints"
-	"hash/fnv"
 )
 
 type SetInterface[T constraints.Ordered] interface {
-	hash(val T) int      // helper function to assign val to an index in the underlying array
-	resize()             // helper function to double the size of the underlying array
 	Contains(val T) bool // returns whether set contains element val. O(1)
 	Insert(val T)        // inserts val in set if not present, increases size by 1. O(1)
 	Remove(val T)        // removes item from set if present, decreases size by 1. O(1)
@@ -17,40 +13,62 @@ type SetInterface[T constraints.Ordered] interface {
 	Values() []T         // returns all values in the set.
 }
 
-type Set[T constraints.Ordered, V] struct {
-	arr     []BinarySearchTree[T, V]
+var _ SetInterface[int] = (*Set[int])(nil)
+
+// Set implements a hash set using a slice of binary search trees for collision resolution.
+type Set[T constraints.Ordered] struct {
+	arr     []*BinarySearchTree[T, struct{}]
 	maxFill float32
 	size    int
+	hasher  HashFunc[T]
 }
 
 func NewSet[T constraints.Ordered]() *Set[T] {
+	return NewSetWithHash[T](DefaultHash[T])
+}
+
+// NewSetWithHash creates a new Set that places values using hasher.
+func NewSetWithHash[T constraints.Ordered](hasher HashFunc[T]) *Set[T] {
 	initialSize := 8 // Starting size of the array
 	return &Set[T]{
-		arr:     make([]BinarySearchTree[T], initialSize),
+		arr:     newSetBuckets[T](initialSize),
 		maxFill: 0.75, // Example fill factor before resizing
+		hasher:  hasher,
+	}
+}
+
+// FromSlice creates a new Set holding the distinct values of vals.
+func FromSlice[T constraints.Ordered](vals []T) *Set[T] {
+	s := NewSet[T]()
+	for _, val := range vals {
+		s.Insert(val)
 	}
+	return s
+}
+
+func newSetBuckets[T constraints.Ordered](n int) []*BinarySearchTree[T, struct{}] {
+	arr := make([]*BinarySearchTree[T, struct{}], n)
+	for i := range arr {
+		arr[i] = NewBinarySearchTree[T, struct{}]()
+	}
+	return arr
 }
 
 func (s *Set[T]) hash(val T) int {
-	h := fnv.New32a()
-	// Convert val to bytes. Note: This simplistic approach might need refinement for complex types T.
-	valBytes, _ := json.Marshal(val)
-	h.Write(valBytes)
 	// Use the hash value to find an index within the array bounds.
-	return int(h.Sum32()) % len(s.arr)
+	return int(s.hasher(val) % uint64(len(s.arr)))
 }
 
 func (s *Set[T]) resize() {
 	// Double the size of the array
-	newArr := make([]BinarySearchTree[T], len(s.arr)*2)
+	oldArr := s.arr
+	s.arr = newSetBuckets[T](len(oldArr) * 2)
 	// Rehash and insert all existing elements into the new array
-	for _, tree := range s.arr {
-		for _, val := range tree.Values() {
-			newIndex := int(fnv.New32a().Sum32()) % len(newArr)
-			newArr[newIndex].Insert(val)
+	for _, tree := range oldArr {
+		for _, pair := range tree.InOrderTraversal() {
+			s.arr[s.hash(pair.Key)].Insert(pair.Key, struct{}{})
 		}
 	}
-	s.arr = newArr
 }
 
 func (s *Set[T]) Contains(val T) bool {
@@ -63,7 +81,7 @@ func (s *Set[T]) Insert(val T) {
 		return
 	}
 	index := s.hash(val)
-	s.arr[index].Insert(val)
+	s.arr[index].Insert(val, struct{}{})
 	s.size++
 	if float32(s.size)/float32(len(s.arr)) > s.maxFill {
 		s.resize()
@@ -89,8 +107,132 @@ func (s *Set[T]) Size() int {
 
 func (s *Set[T]) Values() []T {
 	var values []T
+	s.each(func(val T) bool {
+		values = append(values, val)
+		return true
+	})
+	return values
+}
+
+// each calls fn for every element until fn returns false.
+func (s *Set[T]) each(fn func(val T) bool) {
 	for _, tree := range s.arr {
-		values = append(values, tree.Values()...)
+		for _, pair := range tree.InOrderTraversal() {
+			if !fn(pair.Key) {
+				return
+			}
+		}
 	}
-	return values
+}
+
+// smallerFirst orders two sets by size so callers can iterate the smaller one.
+func smallerFirst[T constraints.Ordered](a, b *Set[T]) (*Set[T], *Set[T]) {
+	if a.size <= b.size {
+		return a, b
+	}
+	return b, a
+}
+
+// Clone returns a copy of the set.
+func (s *Set[T]) Clone() *Set[T] {
+	clone := &Set[T]{arr: newSetBuckets[T](len(s.arr)), maxFill: s.maxFill, size: s.size, hasher: s.hasher}
+	for i, tree := range s.arr {
+		for _, pair := range tree.InOrderTraversal() {
+			clone.arr[i].Insert(pair.Key, struct{}{})
+		}
+	}
+	return clone
+}
+
+// Union returns a new set with the elements of both sets.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	small, large := smallerFirst(s, other)
+	result := large.Clone()
+	small.each(func(val T) bool {
+		result.Insert(val)
+		return true
+	})
+	return result
+}
+
+// Intersection returns a new set with the elements present in both sets.
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	small, large := smallerFirst(s, other)
+	result := NewSetWithHash(s.hasher)
+	small.each(func(val T) bool {
+		if large.Contains(val) {
+			result.Insert(val)
+		}
+		return true
+	})
+	return result
+}
+
+// Difference returns a new set with the elements of s that are not in other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	if s.size <= other.size {
+		result := NewSetWithHash(s.hasher)
+		s.each(func(val T) bool {
+			if !other.Contains(val) {
+				result.Insert(val)
+			}
+			return true
+		})
+		return result
+	}
+	result := s.Clone()
+	other.each(func(val T) bool {
+		result.Remove(val)
+		return true
+	})
+	return result
+}
+
+// SymmetricDifference returns a new set with the elements in exactly one of the sets.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	small, large := smallerFirst(s, other)
+	result := large.Clone()
+	small.each(func(val T) bool {
+		if large.Contains(val) {
+			result.Remove(val)
+		} else {
+			result.Insert(val)
+		}
+		return true
+	})
+	return result
+}
+
+// IsSubset returns whether every element of s is in other.
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.size > other.size {
+		return false
+	}
+	subset := true
+	s.each(func(val T) bool {
+		subset = other.Contains(val)
+		return subset
+	})
+	return subset
+}
+
+// IsSuperset returns whether every element of other is in s.
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// IsDisjoint returns whether the sets have no elements in common.
+func (s *Set[T]) IsDisjoint(other *Set[T]) bool {
+	small, large := smallerFirst(s, other)
+	disjoint := true
+	small.each(func(val T) bool {
+		disjoint = !large.Contains(val)
+		return disjoint
+	})
+	return disjoint
+}
+
+// Equal returns whether both sets hold exactly the same elements.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	return s.size == other.size && s.IsSubset(other)
 }
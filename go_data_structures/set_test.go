@@ -0,0 +1,82 @@
+package go_data_structures
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func sortedInts(s *Set[int]) []int {
+	vals := append([]int{}, s.Values()...)
+	sort.Ints(vals)
+	return vals
+}
+
+func TestSetAlgebra(t *testing.T) {
+	same := FromSlice([]int{1, 2, 3})
+	tests := []struct {
+		name                       string
+		a, b                       *Set[int]
+		union, inter, diff, sym    []int
+		subset, superset, disjoint bool
+	}{
+		{"both empty", NewSet[int](), NewSet[int](), []int{}, []int{}, []int{}, []int{}, true, true, true},
+		{"empty left", NewSet[int](), FromSlice([]int{1, 2}), []int{1, 2}, []int{}, []int{}, []int{1, 2}, true, false, true},
+		{"empty right", FromSlice([]int{1, 2}), NewSet[int](), []int{1, 2}, []int{}, []int{1, 2}, []int{1, 2}, false, true, true},
+		{"same set", same, same, []int{1, 2, 3}, []int{1, 2, 3}, []int{}, []int{}, true, true, false},
+		{"equal sets", FromSlice([]int{1, 2, 3}), FromSlice([]int{3, 2, 1}), []int{1, 2, 3}, []int{1, 2, 3}, []int{}, []int{}, true, true, false},
+		{"overlap", FromSlice([]int{1, 2, 3, 4}), FromSlice([]int{3, 4, 5}), []int{1, 2, 3, 4, 5}, []int{3, 4}, []int{1, 2}, []int{1, 2, 5}, false, false, false},
+		{"proper subset", FromSlice([]int{2}), FromSlice([]int{1, 2, 3}), []int{1, 2, 3}, []int{2}, []int{}, []int{1, 3}, true, false, false},
+		{"disjoint", FromSlice([]int{1, 3}), FromSlice([]int{2, 4, 6}), []int{1, 2, 3, 4, 6}, []int{}, []int{1, 3}, []int{1, 2, 3, 4, 6}, false, false, true},
+	}
+	for _, tt := range tests {
+		before, otherBefore := sortedInts(tt.a), sortedInts(tt.b)
+		check := func(op string, got *Set[int], want []int) {
+			if vals := sortedInts(got); !reflect.DeepEqual(vals, want) || got.Size() != len(want) {
+				t.Errorf("%s: %s = %v (Size %d), want %v", tt.name, op, vals, got.Size(), want)
+			}
+		}
+		check("Union", tt.a.Union(tt.b), tt.union)
+		check("Intersection", tt.a.Intersection(tt.b), tt.inter)
+		check("Difference", tt.a.Difference(tt.b), tt.diff)
+		check("SymmetricDifference", tt.a.SymmetricDifference(tt.b), tt.sym)
+		if got := tt.a.IsSubset(tt.b); got != tt.subset {
+			t.Errorf("%s: IsSubset = %v, want %v", tt.name, got, tt.subset)
+		}
+		if got := tt.a.IsSuperset(tt.b); got != tt.superset {
+			t.Errorf("%s: IsSuperset = %v, want %v", tt.name, got, tt.superset)
+		}
+		if got := tt.a.IsDisjoint(tt.b); got != tt.disjoint {
+			t.Errorf("%s: IsDisjoint = %v, want %v", tt.name, got, tt.disjoint)
+		}
+		if got := tt.a.Equal(tt.b); got != (tt.subset && tt.superset) {
+			t.Errorf("%s: Equal = %v", tt.name, got)
+		}
+		// The operations return new sets and leave their operands alone.
+		if !reflect.DeepEqual(sortedInts(tt.a), before) || !reflect.DeepEqual(sortedInts(tt.b), otherBefore) {
+			t.Errorf("%s: an operation modified its operands", tt.name)
+		}
+	}
+}
+
+func TestSetInsertRemove(t *testing.T) {
+	s := NewSet[int]()
+	for i := 0; i < 1000; i++ {
+		s.Insert(i % 500) // every value twice; forces several resizes
+	}
+	if s.Size() != 500 {
+		t.Fatalf("Size() = %d, want 500", s.Size())
+	}
+	for i := 0; i < 500; i += 2 {
+		s.Remove(i)
+	}
+	s.Remove(10000)
+	if s.Size() != 250 || s.Contains(0) || !s.Contains(1) {
+		t.Fatalf("after removals Size() = %d", s.Size())
+	}
+	clone := s.Clone()
+	clone.Insert(0)
+	if s.Contains(0) || clone.Size() != 251 {
+		t.Fatalf("Clone shares storage with the original")
+	}
+}
@@ -0,0 +1,229 @@
+package go_data_structures
+
+import (
+	"golang.org/x/exp/constraints"
+)
+
+// setBound is one end of a SortedSet view. An unset bound is unbounded.
+type setBound[T constraints.Ordered] struct {
+	val       T
+	set       bool
+	inclusive bool
+}
+
+// SortedSet keeps its elements in ascending order using an AVLTree. Views
+// returned by HeadSet, TailSet and SubSet share the tree with the set they
+// came from, so mutations through either are visible in both.
+type SortedSet[T constraints.Ordered] struct {
+	tree *AVLTree[T, struct{}]
+	lo   setBound[T]
+	hi   setBound[T]
+}
+
+var _ SetInterface[int] = (*SortedSet[int])(nil)
+
+// NewSortedSet creates a new, empty SortedSet.
+func NewSortedSet[T constraints.Ordered]() *SortedSet[T] {
+	return &SortedSet[T]{tree: NewAVLTree[T, struct{}]()}
+}
+
+// aboveLo returns whether val satisfies the lower bound of the view.
+func (ss *SortedSet[T]) aboveLo(val T) bool {
+	return !ss.lo.set || val > ss.lo.val || (ss.lo.inclusive && val == ss.lo.val)
+}
+
+// belowHi returns whether val satisfies the upper bound of the view.
+func (ss *SortedSet[T]) belowHi(val T) bool {
+	return !ss.hi.set || val < ss.hi.val || (ss.hi.inclusive && val == ss.hi.val)
+}
+
+func (ss *SortedSet[T]) inRange(val T) bool {
+	return ss.aboveLo(val) && ss.belowHi(val)
+}
+
+// Contains returns whether val is in the set (and within the view's range). O(log n)
+func (ss *SortedSet[T]) Contains(val T) bool {
+	return ss.inRange(val) && ss.tree.Contains(val)
+}
+
+// Insert adds val to the set. Values outside a view's range are ignored. O(log n)
+func (ss *SortedSet[T]) Insert(val T) {
+	if ss.inRange(val) {
+		ss.tree.Insert(val, struct{}{})
+	}
+}
+
+// Remove deletes val from the set if present. O(log n)
+func (ss *SortedSet[T]) Remove(val T) {
+	if ss.inRange(val) {
+		ss.tree.Remove(val)
+	}
+}
+
+// Empty returns whether the set (or view) has no elements.
+func (ss *SortedSet[T]) Empty() bool {
+	_, ok := ss.First()
+	return !ok
+}
+
+// Size returns the number of elements. O(1) for a whole set, O(k) for a view.
+func (ss *SortedSet[T]) Size() int {
+	if !ss.lo.set && !ss.hi.set {
+		return ss.tree.Size()
+	}
+	n := 0
+	ss.ascend(ss.tree.root, func(T) { n++ })
+	return n
+}
+
+// Values returns the elements in ascending order.
+func (ss *SortedSet[T]) Values() []T {
+	var values []T
+	ss.ascend(ss.tree.root, func(val T) { values = append(values, val) })
+	return values
+}
+
+// ascend visits the in-range elements under node in ascending order,
+// skipping subtrees that lie entirely outside the view.
+func (ss *SortedSet[T]) ascend(node *BinaryTreeNode[T, struct{}], fn func(val T)) {
+	if node == nil {
+		return
+	}
+	aboveLo, belowHi := ss.aboveLo(node.Data.Key), ss.belowHi(node.Data.Key)
+	if aboveLo {
+		ss.ascend(node.Left, fn)
+	}
+	if aboveLo && belowHi {
+		fn(node.Data.Key)
+	}
+	if belowHi {
+		ss.ascend(node.Right, fn)
+	}
+}
+
+// ceiling returns the smallest in-range element >= val, or > val when
+// inclusive is false.
+func (ss *SortedSet[T]) ceiling(val T, inclusive bool) (T, bool) {
+	if !ss.aboveLo(val) {
+		val, inclusive = ss.lo.val, ss.lo.inclusive
+	}
+	node := ceilingNode(ss.tree.root, val, inclusive)
+	if node == nil || !ss.belowHi(node.Data.Key) {
+		var zero T
+		return zero, false
+	}
+	return node.Data.Key, true
+}
+
+// floor returns the largest in-range element <= val, or < val when
+// inclusive is false.
+func (ss *SortedSet[T]) floor(val T, inclusive bool) (T, bool) {
+	if !ss.belowHi(val) {
+		val, inclusive = ss.hi.val, ss.hi.inclusive
+	}
+	node := floorNode(ss.tree.root, val, inclusive)
+	if node == nil || !ss.aboveLo(node.Data.Key) {
+		var zero T
+		return zero, false
+	}
+	return node.Data.Key, true
+}
+
+// First returns the smallest element. O(log n)
+func (ss *SortedSet[T]) First() (T, bool) {
+	if ss.lo.set {
+		return ss.ceiling(ss.lo.val, ss.lo.inclusive)
+	}
+	if ss.tree.root == nil {
+		var zero T
+		return zero, false
+	}
+	first := findMin(ss.tree.root).Data.Key
+	if !ss.belowHi(first) {
+		var zero T
+		return zero, false
+	}
+	return first, true
+}
+
+// Last returns the largest element. O(log n)
+func (ss *SortedSet[T]) Last() (T, bool) {
+	if ss.hi.set {
+		return ss.floor(ss.hi.val, ss.hi.inclusive)
+	}
+	if ss.tree.root == nil {
+		var zero T
+		return zero, false
+	}
+	last := findMax(ss.tree.root).Data.Key
+	if !ss.aboveLo(last) {
+		var zero T
+		return zero, false
+	}
+	return last, true
+}
+
+// Floor returns the largest element <= val. O(log n)
+func (ss *SortedSet[T]) Floor(val T) (T, bool) {
+	return ss.floor(val, true)
+}
+
+// Lower returns the largest element < val. O(log n)
+func (ss *SortedSet[T]) Lower(val T) (T, bool) {
+	return ss.floor(val, false)
+}
+
+// Ceiling returns the smallest element >= val. O(log n)
+func (ss *SortedSet[T]) Ceiling(val T) (T, bool) {
+	return ss.ceiling(val, true)
+}
+
+// Higher returns the smallest element > val. O(log n)
+func (ss *SortedSet[T]) Higher(val T) (T, bool) {
+	return ss.ceiling(val, false)
+}
+
+// PollFirst removes and returns the smallest element. O(log n)
+func (ss *SortedSet[T]) PollFirst() (T, bool) {
+	first, ok := ss.First()
+	if ok {
+		ss.tree.Remove(first)
+	}
+	return first, ok
+}
+
+// PollLast removes and returns the largest element. O(log n)
+func (ss *SortedSet[T]) PollLast() (T, bool) {
+	last, ok := ss.Last()
+	if ok {
+		ss.tree.Remove(last)
+	}
+	return last, ok
+}
+
+// view returns a SortedSet sharing ss's tree, narrowed to the given bounds.
+func (ss *SortedSet[T]) view(lo, hi setBound[T]) *SortedSet[T] {
+	v := &SortedSet[T]{tree: ss.tree, lo: ss.lo, hi: ss.hi}
+	if lo.set && (!v.lo.set || (v.aboveLo(lo.val) && (lo.val != v.lo.val || !lo.inclusive))) {
+		v.lo = lo
+	}
+	if hi.set && (!v.hi.set || (v.belowHi(hi.val) && (hi.val != v.hi.val || !hi.inclusive))) {
+		v.hi = hi
+	}
+	return v
+}
+
+// HeadSet returns a live view of the elements strictly less than to.
+func (ss *SortedSet[T]) HeadSet(to T) *SortedSet[T] {
+	return ss.view(setBound[T]{}, setBound[T]{val: to, set: true})
+}
+
+// TailSet returns a live view of the elements greater than or equal to from.
+func (ss *SortedSet[T]) TailSet(from T) *SortedSet[T] {
+	return ss.view(setBound[T]{val: from, set: true, inclusive: true}, setBound[T]{})
+}
+
+// SubSet returns a live view of the elements in [from, to).
+func (ss *SortedSet[T]) SubSet(from, to T) *SortedSet[T] {
+	return ss.view(setBound[T]{val: from, set: true, inclusive: true}, setBound[T]{val: to, set: true})
+}
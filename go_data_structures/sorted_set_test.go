@@ -0,0 +1,176 @@
+package go_data_structures
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// sortedModel is a sorted slice standing in for a SortedSet, optionally
+// narrowed to the elements for which in returns true.
+type sortedModel struct {
+	vals []int
+	in   func(int) bool
+}
+
+func (m *sortedModel) insert(v int) {
+	i := sort.SearchInts(m.vals, v)
+	if i == len(m.vals) || m.vals[i] != v {
+		m.vals = append(m.vals[:i], append([]int{v}, m.vals[i:]...)...)
+	}
+}
+
+func (m *sortedModel) remove(v int) {
+	if i := sort.SearchInts(m.vals, v); i < len(m.vals) && m.vals[i] == v {
+		m.vals = append(m.vals[:i], m.vals[i+1:]...)
+	}
+}
+
+// visible returns the model's elements inside the view.
+func (m *sortedModel) visible(in func(int) bool) []int {
+	out := []int{}
+	for _, v := range m.vals {
+		if in == nil || in(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// checkSortedSet compares every query on ss with the elements want,
+// probing values from lo to hi.
+func checkSortedSet(t *testing.T, name string, ss *SortedSet[int], want []int, lo, hi int) {
+	t.Helper()
+	got := ss.Values()
+	if got == nil {
+		got = []int{}
+	}
+	if !reflect.DeepEqual(got, want) || ss.Size() != len(want) || ss.Empty() != (len(want) == 0) {
+		t.Fatalf("%s: Values() = %v, Size() = %d, want %v", name, got, ss.Size(), want)
+	}
+	expect := func(op string, probe, v int, ok bool, i int, found bool) {
+		t.Helper()
+		if ok != found || (found && v != want[i]) {
+			t.Fatalf("%s: %s(%d) = %d, %v", name, op, probe, v, ok)
+		}
+	}
+	v, ok := ss.First()
+	expect("First", 0, v, ok, 0, len(want) > 0)
+	v, ok = ss.Last()
+	expect("Last", 0, v, ok, len(want)-1, len(want) > 0)
+	for p := lo; p <= hi; p++ {
+		i := sort.SearchInts(want, p) // first >= p
+		j := sort.SearchInts(want, p+1)
+		v, ok = ss.Ceiling(p)
+		expect("Ceiling", p, v, ok, i, i < len(want))
+		v, ok = ss.Higher(p)
+		expect("Higher", p, v, ok, j, j < len(want))
+		v, ok = ss.Floor(p)
+		expect("Floor", p, v, ok, j-1, j > 0)
+		v, ok = ss.Lower(p)
+		expect("Lower", p, v, ok, i-1, i > 0)
+		if ss.Contains(p) != (i < len(want) && want[i] == p) {
+			t.Fatalf("%s: Contains(%d) = %v", name, p, ss.Contains(p))
+		}
+	}
+}
+
+func TestSortedSetMatchesModel(t *testing.T) {
+	r := rand.New(rand.NewSource(14))
+	ss := NewSortedSet[int]()
+	model := &sortedModel{}
+	for op := 0; op < 1500; op++ {
+		v := r.Intn(100)
+		switch r.Intn(6) {
+		case 0, 1, 2:
+			ss.Insert(v)
+			model.insert(v)
+		case 3:
+			ss.Remove(v)
+			model.remove(v)
+		case 4:
+			got, ok := ss.PollFirst()
+			if ok != (len(model.vals) > 0) || (ok && got != model.vals[0]) {
+				t.Fatalf("PollFirst() = %d, %v", got, ok)
+			}
+			if ok {
+				model.remove(got)
+			}
+		case 5:
+			got, ok := ss.PollLast()
+			if ok != (len(model.vals) > 0) || (ok && got != model.vals[len(model.vals)-1]) {
+				t.Fatalf("PollLast() = %d, %v", got, ok)
+			}
+			if ok {
+				model.remove(got)
+			}
+		}
+		if op%50 == 0 {
+			checkSortedSet(t, "set", ss, model.visible(nil), -2, 102)
+		}
+	}
+}
+
+func TestSortedSetViewsAreLive(t *testing.T) {
+	r := rand.New(rand.NewSource(15))
+	ss := NewSortedSet[int]()
+	model := &sortedModel{}
+	head := ss.HeadSet(40)
+	tail := ss.TailSet(60)
+	sub := ss.SubSet(20, 80)
+	views := []struct {
+		name string
+		set  *SortedSet[int]
+		in   func(int) bool
+	}{
+		{"HeadSet(40)", head, func(v int) bool { return v < 40 }},
+		{"TailSet(60)", tail, func(v int) bool { return v >= 60 }},
+		{"SubSet(20, 80)", sub, func(v int) bool { return v >= 20 && v < 80 }},
+		// Views of views narrow further, and never widen past their parent.
+		{"SubSet(20, 80).HeadSet(50)", sub.HeadSet(50), func(v int) bool { return v >= 20 && v < 50 }},
+		{"SubSet(20, 80).TailSet(10)", sub.TailSet(10), func(v int) bool { return v >= 20 && v < 80 }},
+		{"HeadSet(40).SubSet(30, 90)", head.SubSet(30, 90), func(v int) bool { return v >= 30 && v < 40 }},
+		{"TailSet(60).SubSet(0, 60)", tail.SubSet(0, 60), func(v int) bool { return false }},
+	}
+	for op := 0; op < 600; op++ {
+		v := r.Intn(100)
+		// Write through the parent, or through a view: a view ignores
+		// writes outside its bounds, and passes the rest to the parent.
+		target := ss
+		in := func(int) bool { return true }
+		if k := r.Intn(len(views) + 1); k < len(views) {
+			target, in = views[k].set, views[k].in
+		}
+		if r.Intn(3) == 0 {
+			target.Remove(v)
+			if in(v) {
+				model.remove(v)
+			}
+		} else {
+			target.Insert(v)
+			if in(v) {
+				model.insert(v)
+			}
+		}
+		if op%40 == 0 {
+			checkSortedSet(t, "set", ss, model.visible(nil), -2, 102)
+			for _, view := range views {
+				checkSortedSet(t, view.name, view.set, model.visible(view.in), -2, 102)
+			}
+		}
+	}
+
+	// Polling through a view only takes elements inside it.
+	for {
+		v, ok := sub.PollFirst()
+		if !ok {
+			break
+		}
+		if v < 20 || v >= 80 {
+			t.Fatalf("SubSet(20, 80).PollFirst() = %d", v)
+		}
+		model.remove(v)
+	}
+	checkSortedSet(t, "set after draining SubSet", ss, model.visible(nil), -2, 102)
+}
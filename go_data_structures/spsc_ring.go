@@ -0,0 +1,91 @@
+package go_data_structures
+
+import (
+	"runtime"
+	"sync/atomic"
+)
+
+// SPSCRing is a bounded wait-free queue for exactly one producer goroutine
+// and one consumer goroutine. Each side only writes its own index, so no
+// operation ever retries. Enqueue and TryEnqueue belong to the producer;
+// Dequeue, TryDequeue and Front to the consumer.
+type SPSCRing[T any] struct {
+	_    [cacheLineSize]byte
+	head atomic.Uint64 // next position to read; written by the consumer
+	_    [cacheLineSize - 8]byte
+	tail atomic.Uint64 // next position to write; written by the producer
+	_    [cacheLineSize - 8]byte
+	buf  []T
+	mask uint64
+}
+
+var _ QueueInterface[int] = (*SPSCRing[int])(nil)
+
+// NewSPSCRing creates a ring holding at most capacity items, rounded up to
+// a power of two.
+func NewSPSCRing[T any](capacity int) *SPSCRing[T] {
+	n := nextPowerOfTwo(max(capacity, 2))
+	return &SPSCRing[T]{buf: make([]T, n), mask: uint64(n - 1)}
+}
+
+// TryEnqueue adds val to the back, returning false if the ring is full.
+func (r *SPSCRing[T]) TryEnqueue(val T) bool {
+	tail := r.tail.Load()
+	if tail-r.head.Load() == uint64(len(r.buf)) {
+		return false
+	}
+	r.buf[tail&r.mask] = val
+	r.tail.Store(tail + 1)
+	return true
+}
+
+// TryDequeue removes and returns the front item, and false if the ring is empty.
+func (r *SPSCRing[T]) TryDequeue() (T, bool) {
+	var zero T
+	head := r.head.Load()
+	if head == r.tail.Load() {
+		return zero, false
+	}
+	val := r.buf[head&r.mask]
+	r.buf[head&r.mask] = zero // release the item before the producer wraps round to the slot
+	r.head.Store(head + 1)
+	return val, true
+}
+
+// Enqueue adds val to the back, yielding the processor until there is room.
+func (r *SPSCRing[T]) Enqueue(val T) {
+	for !r.TryEnqueue(val) {
+		runtime.Gosched()
+	}
+}
+
+// Dequeue removes the front item, if any.
+func (r *SPSCRing[T]) Dequeue() {
+	r.TryDequeue()
+}
+
+// Front returns the front item, or the zero value if the ring is empty.
+func (r *SPSCRing[T]) Front() T {
+	head := r.head.Load()
+	if head == r.tail.Load() {
+		var zero T
+		return zero
+	}
+	return r.buf[head&r.mask]
+}
+
+// Empty returns whether the ring is empty. Under concurrent use the answer may be stale.
+func (r *SPSCRing[T]) Empty() bool {
+	return r.Size() == 0
+}
+
+// Size returns the number of items in the ring. Under concurrent use the answer may be stale.
+func (r *SPSCRing[T]) Size() int {
+	head := r.head.Load()
+	return int(r.tail.Load() - head)
+}
+
+// Cap returns the most items the ring holds.
+func (r *SPSCRing[T]) Cap() int {
+	return len(r.buf)
+}
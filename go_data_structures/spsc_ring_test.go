@@ -0,0 +1,85 @@
+package go_data_structures
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestSPSCRingFIFO(t *testing.T) {
+	const n = 20000
+	r := NewSPSCRing[int](8) // small, so the indices wrap many times
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for want := 0; want < n; {
+			v, ok := r.TryDequeue()
+			if !ok {
+				runtime.Gosched()
+				continue
+			}
+			if v != want {
+				t.Errorf("TryDequeue() = %d, want %d", v, want)
+				return
+			}
+			want++
+		}
+	}()
+	for i := 0; i < n; i++ {
+		r.Enqueue(i)
+	}
+	<-done
+	if !r.Empty() {
+		t.Fatalf("ring not empty after the run: Size() = %d", r.Size())
+	}
+}
+
+func TestSPSCRingBounds(t *testing.T) {
+	r := NewSPSCRing[int](2)
+	if !r.TryEnqueue(1) || !r.TryEnqueue(2) || r.TryEnqueue(3) {
+		t.Fatalf("TryEnqueue should fill a ring of 2 and then fail")
+	}
+	if r.Front() != 1 || r.Size() != 2 {
+		t.Fatalf("Front() = %d, Size() = %d, want 1 and 2", r.Front(), r.Size())
+	}
+	r.Dequeue()
+	if v, ok := r.TryDequeue(); !ok || v != 2 {
+		t.Fatalf("TryDequeue() = %d, %v, want 2", v, ok)
+	}
+	if _, ok := r.TryDequeue(); ok || r.Front() != 0 {
+		t.Fatalf("empty ring returned an item")
+	}
+}
+
+// BenchmarkSPSC streams b.N items from one producer goroutine to one consumer.
+func BenchmarkSPSC(b *testing.B) {
+	b.Run("SPSCRing", func(b *testing.B) {
+		r := NewSPSCRing[int](1024)
+		go func() {
+			for i := 0; i < b.N; i++ {
+				r.Enqueue(i)
+			}
+		}()
+		for i := 0; i < b.N; {
+			if _, ok := r.TryDequeue(); ok {
+				i++
+			} else {
+				runtime.Gosched()
+			}
+		}
+	})
+	b.Run("MutexAlternateQueue", func(b *testing.B) {
+		q := newMutexQueue[int]()
+		go func() {
+			for i := 0; i < b.N; i++ {
+				q.Enqueue(i)
+			}
+		}()
+		for i := 0; i < b.N; {
+			if _, ok := q.TryDequeue(); ok {
+				i++
+			} else {
+				runtime.Gosched()
+			}
+		}
+	})
+}
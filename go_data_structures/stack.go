@@ -3,17 +3,184 @@ package go_data_structures
 
 
 type StackInterface[T any] interface {
-	Top() T      // returns top item in stack. O(1)
-	Push(val T)  // adds val to top of stack, increases size by 1. O(1)
-	Pop()        // removes item from top of stack, decreases size by 1. O(1)
-	Empty() bool // returns whether stack is empty. O(1)
-	Size() int   // returns number of elements in stack. O(1) or O(n) depending on implementation
+	Top() T          // returns top item in stack, or the zero value if empty. O(1)
+	Peek() (T, bool) // returns top item in stack, returns false if empty. O(1)
+	Push(val T)      // adds val to top of stack, increases size by 1. O(1)
+	Pop() (T, bool)  // removes and returns top item, returns false if empty. O(1)
+	Empty() bool     // returns whether stack is empty. O(1)
+	Size() int       // returns number of elements in stack. O(1) or O(n) depending on implementation
 }
 
+// Stack is a stack over a singly linked list: every Push allocates a node,
+// but no push ever copies the existing items.
 type Stack[T any] struct {
 	list SinglyLinkedListInterface[T]
 }
 
+var _ StackInterface[int] = (*Stack[int])(nil)
+
+func NewStack[T any]() *Stack[T] {
+	return &Stack[T]{list: NewSinglyLinkedList[T]()}
+}
+
+// NewStackFrom creates a stack holding vals, with the last one on top.
+func NewStackFrom[T any](vals []T) *Stack[T] {
+	s := NewStack[T]()
+	for _, val := range vals {
+		s.Push(val)
+	}
+	return s
+}
+
+func (s *Stack[T]) Top() T {
+	top, _ := s.Peek()
+	return top
+}
+
+func (s *Stack[T]) Peek() (T, bool) {
+	if s.list.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+	return s.list.PeekFront(), true
+}
+
+func (s *Stack[T]) Push(val T) {
+	s.list.InsertAtFront(val)
+}
+
+func (s *Stack[T]) Pop() (T, bool) {
+	if s.list.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+	return s.list.PopFront(), true
+}
+
+func (s *Stack[T]) Empty() bool {
+	return s.list.IsEmpty()
+}
+
+func (s *Stack[T]) Size() int {
+	return s.list.Count()
+}
+
+// Clear removes every item.
+func (s *Stack[T]) Clear() {
+	s.list = NewSinglyLinkedList[T]()
+}
+
+// Clone returns a copy of the stack. O(n)
+func (s *Stack[T]) Clone() *Stack[T] {
+	clone := NewStack[T]()
+	for node := s.list.Head(); node != nil; node = node.Next {
+		clone.list.InsertAtEnd(node.Data)
+	}
+	return clone
+}
+
+// Each calls fn on every item from top to bottom until fn returns false.
+func (s *Stack[T]) Each(fn func(val T) bool) {
+	for node := s.list.Head(); node != nil; node = node.Next {
+		if !fn(node.Data) {
+			return
+		}
+	}
+}
+
+// Values returns the items from top to bottom.
+func (s *Stack[T]) Values() []T {
+	vals := make([]T, 0, s.Size())
+	s.Each(func(val T) bool {
+		vals = append(vals, val)
+		return true
+	})
+	return vals
+}
+
+// AlternateStack is a stack over a slice: pushes are amortized O(1) with no
+// per-item allocation, at the cost of occasionally copying the slice to grow it.
 type AlternateStack[T any] struct {
 	arr []T // array implementation of a stack
 }
+
+var _ StackInterface[int] = (*AlternateStack[int])(nil)
+
+func NewAlternateStack[T any]() *AlternateStack[T] {
+	return &AlternateStack[T]{arr: make([]T, 0)}
+}
+
+// NewAlternateStackFrom creates a stack holding a copy of vals, with the last one on top.
+func NewAlternateStackFrom[T any](vals []T) *AlternateStack[T] {
+	arr := make([]T, len(vals))
+	copy(arr, vals)
+	return &AlternateStack[T]{arr: arr}
+}
+
+func (s *AlternateStack[T]) Top() T {
+	top, _ := s.Peek()
+	return top
+}
+
+func (s *AlternateStack[T]) Peek() (T, bool) {
+	if len(s.arr) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.arr[len(s.arr)-1], true
+}
+
+func (s *AlternateStack[T]) Push(val T) {
+	s.arr = append(s.arr, val)
+}
+
+func (s *AlternateStack[T]) Pop() (T, bool) {
+	if len(s.arr) == 0 {
+		var zero T
+		return zero, false
+	}
+	last := len(s.arr) - 1
+	top := s.arr[last]
+	var zero T
+	s.arr[last] = zero // the backing array outlives the shrunk slice
+	s.arr = s.arr[:last]
+	return top, true
+}
+
+func (s *AlternateStack[T]) Empty() bool {
+	return len(s.arr) == 0
+}
+
+func (s *AlternateStack[T]) Size() int {
+	return len(s.arr)
+}
+
+// Clear removes every item, keeping the allocated capacity.
+func (s *AlternateStack[T]) Clear() {
+	clear(s.arr)
+	s.arr = s.arr[:0]
+}
+
+// Clone returns a copy of the stack. O(n)
+func (s *AlternateStack[T]) Clone() *AlternateStack[T] {
+	return NewAlternateStackFrom(s.arr)
+}
+
+// Each calls fn on every item from top to bottom until fn returns false.
+func (s *AlternateStack[T]) Each(fn func(val T) bool) {
+	for i := len(s.arr) - 1; i >= 0; i-- {
+		if !fn(s.arr[i]) {
+			return
+		}
+	}
+}
+
+// Values returns the items from top to bottom.
+func (s *AlternateStack[T]) Values() []T {
+	vals := make([]T, 0, len(s.arr))
+	s.Each(func(val T) bool {
+		vals = append(vals, val)
+		return true
+	})
+	return vals
+}
@@ -0,0 +1,58 @@
+package go_data_structures
+
+import "testing"
+
+func testStack(t *testing.T, s StackInterface[int]) {
+	if s.Top() != 0 || !s.Empty() {
+		t.Fatalf("Top() of an empty stack = %d, want 0", s.Top())
+	}
+	if _, ok := s.Peek(); ok {
+		t.Fatalf("Peek succeeded on an empty stack")
+	}
+	for i := 1; i <= 100; i++ {
+		s.Push(i)
+		if s.Top() != i || s.Size() != i {
+			t.Fatalf("after Push(%d): Top() = %d, Size() = %d", i, s.Top(), s.Size())
+		}
+	}
+	for want := 100; want >= 1; want-- {
+		if top, ok := s.Peek(); !ok || top != want {
+			t.Fatalf("Peek() = %d, %v, want %d", top, ok, want)
+		}
+		if got, ok := s.Pop(); !ok || got != want {
+			t.Fatalf("Pop() = %d, %v, want %d", got, ok, want)
+		}
+	}
+	if _, ok := s.Pop(); ok || !s.Empty() {
+		t.Fatalf("Pop succeeded on an empty stack")
+	}
+}
+
+func TestStack(t *testing.T) {
+	testStack(t, NewStack[int]())
+}
+
+func TestAlternateStack(t *testing.T) {
+	testStack(t, NewAlternateStack[int]())
+}
+
+func benchmarkStack(b *testing.B, newStack func() StackInterface[int]) {
+	const n = 1000
+	for i := 0; i < b.N; i++ {
+		s := newStack()
+		for j := 0; j < n; j++ {
+			s.Push(j)
+		}
+		for !s.Empty() {
+			s.Pop()
+		}
+	}
+}
+
+func BenchmarkStackLinked(b *testing.B) {
+	benchmarkStack(b, func() StackInterface[int] { return NewStack[int]() })
+}
+
+func BenchmarkStackSlice(b *testing.B) {
+	benchmarkStack(b, func() StackInterface[int] { return NewAlternateStack[int]() })
+}
@@ -0,0 +1,170 @@
+package go_data_structures
+
+import (
+	"time"
+)
+
+// WheelTimer is one value scheduled on a TimingWheel; Schedule returns it
+// as a handle for Cancel.
+type WheelTimer[T any] struct {
+	value      T
+	expiry     int64          // tick at which the timer fires
+	prev, next *WheelTimer[T] // circular list of the slot's timers; nil when not scheduled
+}
+
+// Value returns the scheduled value.
+func (wt *WheelTimer[T]) Value() T {
+	return wt.value
+}
+
+// TimingWheel is a hierarchical timing wheel: level 0 has one slot per tick,
+// and each slot of level l spans a full turn of level l-1. A timer waits in
+// the coarsest level that fits it and cascades down as its deadline nears,
+// so Schedule and Cancel are O(1) however many timers are pending. Time only
+// moves on Advance, which the caller drives, typically from a ticker. It is
+// not safe for concurrent use.
+type TimingWheel[T any] struct {
+	tick    time.Duration
+	slots   int
+	levels  [][]*WheelTimer[T] // levels[l][i] is the sentinel of slot i's list
+	start   time.Time
+	current int64 // last tick processed
+	size    int
+	clock   Clock
+}
+
+// NewTimingWheel creates a wheel that resolves deadlines to tick, with
+// slotsPerLevel slots in each level, reading time from clock, or from
+// SystemClock if clock is nil. Levels are added as longer timers need them.
+func NewTimingWheel[T any](tick time.Duration, slotsPerLevel int, clock Clock) *TimingWheel[T] {
+	if clock == nil {
+		clock = SystemClock
+	}
+	return &TimingWheel[T]{tick: max(tick, 1), slots: max(slotsPerLevel, 2), start: clock.Now(), clock: clock}
+}
+
+func (tw *TimingWheel[T]) addLevel() {
+	level := make([]*WheelTimer[T], tw.slots)
+	for i := range level {
+		sentinel := &WheelTimer[T]{}
+		sentinel.prev, sentinel.next = sentinel, sentinel
+		level[i] = sentinel
+	}
+	tw.levels = append(tw.levels, level)
+}
+
+// place links wt into the slot that will next be visited at or just before
+// its expiry: the first level whose span exceeds the remaining delay.
+func (tw *TimingWheel[T]) place(wt *WheelTimer[T]) {
+	delay := wt.expiry - tw.current
+	span := int64(tw.slots) // ticks covered by one turn of the level
+	scale := int64(1)       // ticks covered by one slot of the level
+	level := 0
+	for delay >= span && span <= (1<<62)/int64(tw.slots) {
+		scale = span
+		span *= int64(tw.slots)
+		level++
+	}
+	for len(tw.levels) <= level {
+		tw.addLevel()
+	}
+	sentinel := tw.levels[level][(wt.expiry/scale)%int64(tw.slots)]
+	wt.prev, wt.next = sentinel.prev, sentinel
+	sentinel.prev.next = wt
+	sentinel.prev = wt
+}
+
+func unlinkTimer[T any](wt *WheelTimer[T]) {
+	wt.prev.next, wt.next.prev = wt.next, wt.prev
+	wt.prev, wt.next = nil, nil
+}
+
+// Schedule arranges for val to be returned by the first Advance at least
+// delay from now, and returns a handle that can cancel it. O(1)
+func (tw *TimingWheel[T]) Schedule(val T, delay time.Duration) *WheelTimer[T] {
+	return tw.ScheduleAt(val, tw.clock.Now().Add(delay))
+}
+
+// ScheduleAt is like Schedule with an absolute deadline. Deadlines that
+// have passed fire on the next tick. O(1)
+func (tw *TimingWheel[T]) ScheduleAt(val T, deadline time.Time) *WheelTimer[T] {
+	since := deadline.Sub(tw.start)
+	expiry := int64(since / tw.tick)
+	if since%tw.tick != 0 {
+		expiry++ // never fire early
+	}
+	wt := &WheelTimer[T]{value: val, expiry: max(expiry, tw.current+1)}
+	tw.place(wt)
+	tw.size++
+	return wt
+}
+
+// Cancel unschedules timer, returning false if it already fired or was cancelled. O(1)
+func (tw *TimingWheel[T]) Cancel(timer *WheelTimer[T]) bool {
+	if timer == nil || timer.next == nil {
+		return false
+	}
+	unlinkTimer(timer)
+	tw.size--
+	return true
+}
+
+// Advance processes every tick up to the clock's current time and returns
+// the values of the timers that fired, earliest first.
+func (tw *TimingWheel[T]) Advance() []T {
+	target := int64(tw.clock.Now().Sub(tw.start) / tw.tick)
+	var fired []T
+	for tw.current < target {
+		if tw.size == 0 {
+			tw.current = target
+			break
+		}
+		tw.current++
+		tw.cascade()
+		sentinel := tw.levels[0][tw.current%int64(tw.slots)]
+		for sentinel.next != sentinel {
+			wt := sentinel.next
+			unlinkTimer(wt)
+			tw.size--
+			fired = append(fired, wt.value)
+		}
+	}
+	return fired
+}
+
+// cascade re-places the timers of every higher-level slot whose span starts
+// at the current tick, moving them to finer levels.
+func (tw *TimingWheel[T]) cascade() {
+	scale := int64(tw.slots)
+	for level := 1; level < len(tw.levels) && tw.current%scale == 0; level++ {
+		sentinel := tw.levels[level][(tw.current/scale)%int64(tw.slots)]
+		first, last := sentinel.next, sentinel.prev
+		if first == sentinel {
+			scale *= int64(tw.slots)
+			continue
+		}
+		sentinel.prev, sentinel.next = sentinel, sentinel
+		last.next = nil
+		for wt := first; wt != nil; {
+			next := wt.next
+			tw.place(wt)
+			wt = next
+		}
+		scale *= int64(tw.slots)
+	}
+}
+
+// NextTick returns when the next tick is due, the earliest an Advance can fire anything.
+func (tw *TimingWheel[T]) NextTick() time.Time {
+	return tw.start.Add(time.Duration(tw.current+1) * tw.tick)
+}
+
+// Empty returns whether no timers are scheduled. O(1)
+func (tw *TimingWheel[T]) Empty() bool {
+	return tw.size == 0
+}
+
+// Size returns the number of timers scheduled. O(1)
+func (tw *TimingWheel[T]) Size() int {
+	return tw.size
+}
@@ -0,0 +1,97 @@
+package go_data_structures
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTimingWheelFires(t *testing.T) {
+	fc := NewFakeClock(time.Unix(0, 0))
+	tw := NewTimingWheel[string](time.Millisecond, 8, fc)
+	tw.Schedule("b", 5*time.Millisecond)
+	tw.Schedule("a", 3*time.Millisecond)
+	tw.Schedule("a2", 2500*time.Microsecond) // rounds up to tick 3
+	fc.Advance(2 * time.Millisecond)
+	if fired := tw.Advance(); len(fired) != 0 {
+		t.Fatalf("Advance at 2ms fired %v", fired)
+	}
+	fc.Advance(time.Millisecond)
+	if fired := tw.Advance(); !reflect.DeepEqual(fired, []string{"a", "a2"}) {
+		t.Fatalf("Advance at 3ms fired %v, want [a a2]", fired)
+	}
+	if got := tw.NextTick(); !got.Equal(time.Unix(0, 0).Add(4 * time.Millisecond)) {
+		t.Fatalf("NextTick() = %v, want 4ms", got)
+	}
+	fc.Advance(10 * time.Millisecond)
+	if fired := tw.Advance(); !reflect.DeepEqual(fired, []string{"b"}) || !tw.Empty() {
+		t.Fatalf("Advance at 13ms fired %v, want [b]", fired)
+	}
+}
+
+func TestTimingWheelCascade(t *testing.T) {
+	// Four slots per level, so delays up to 500 ticks need five levels and
+	// most timers cascade several times before firing.
+	fc := NewFakeClock(time.Unix(0, 0))
+	tw := NewTimingWheel[int](time.Millisecond, 4, fc)
+	r := rand.New(rand.NewSource(11))
+	due := make(map[int]int64) // timer id -> tick it must fire on
+	for id := 0; id < 300; id++ {
+		ticks := int64(1 + r.Intn(500))
+		tw.Schedule(id, time.Duration(ticks)*time.Millisecond)
+		due[id] = ticks
+	}
+	for tick := int64(1); tick <= 500; tick++ {
+		fc.Advance(time.Millisecond)
+		for _, id := range tw.Advance() {
+			if due[id] != tick {
+				t.Fatalf("timer %d fired at tick %d, want %d", id, tick, due[id])
+			}
+			delete(due, id)
+		}
+		if tw.Size() != len(due) {
+			t.Fatalf("tick %d: Size() = %d, want %d", tick, tw.Size(), len(due))
+		}
+	}
+	if len(due) != 0 {
+		t.Fatalf("%d timers never fired", len(due))
+	}
+
+	// One big jump fires everything due, earliest first.
+	for _, ticks := range []int{70, 5, 300, 18} {
+		tw.Schedule(ticks, time.Duration(ticks)*time.Millisecond)
+	}
+	fc.Advance(time.Second)
+	if fired := tw.Advance(); !reflect.DeepEqual(fired, []int{5, 18, 70, 300}) {
+		t.Fatalf("Advance after a jump fired %v, want [5 18 70 300]", fired)
+	}
+}
+
+func TestTimingWheelCancel(t *testing.T) {
+	fc := NewFakeClock(time.Unix(0, 0))
+	tw := NewTimingWheel[int](time.Millisecond, 4, fc)
+	var timers []*WheelTimer[int]
+	for ticks := 1; ticks <= 100; ticks++ {
+		timers = append(timers, tw.Schedule(ticks, time.Duration(ticks)*time.Millisecond))
+	}
+	// Cancel every third timer, in every level.
+	for i := 0; i < len(timers); i += 3 {
+		if !tw.Cancel(timers[i]) || tw.Cancel(timers[i]) {
+			t.Fatalf("Cancel of timer %d should succeed exactly once", i+1)
+		}
+	}
+	fc.Advance(100 * time.Millisecond)
+	fired := tw.Advance()
+	if len(fired) != 66 || !tw.Empty() {
+		t.Fatalf("fired %d timers, want 66", len(fired))
+	}
+	for _, v := range fired {
+		if (v-1)%3 == 0 {
+			t.Fatalf("cancelled timer %d fired", v)
+		}
+	}
+	if tw.Cancel(timers[1]) || tw.Cancel(nil) {
+		t.Fatalf("Cancel succeeded on a fired timer")
+	}
+}
@@ -0,0 +1,62 @@
+package go_data_structures
+
+import (
+	"sort"
+)
+
+// TopK keeps the k largest items of a stream, where "largest" follows less.
+// It holds them in a min-heap so the smallest kept item is ejected first.
+type TopK[T any] struct {
+	heap *Heap[T]
+	k    int
+}
+
+// NewTopK creates a TopK that keeps up to k items ordered by less.
+func NewTopK[T any](k int, less func(a, b T) bool) *TopK[T] {
+	return &TopK[T]{heap: NewHeap(less), k: max(k, 0)}
+}
+
+// Add offers val, returning whether it was kept. O(log k)
+func (tk *TopK[T]) Add(val T) bool {
+	if tk.k == 0 {
+		return false
+	}
+	if tk.heap.Size() < tk.k {
+		tk.heap.Push(val)
+		return true
+	}
+	if top, _ := tk.heap.Peek(); !tk.heap.less(top, val) {
+		return false
+	}
+	tk.heap.Replace(val)
+	return true
+}
+
+// Min returns the smallest item kept, the one the next larger item would
+// eject, and false if nothing is kept. O(1)
+func (tk *TopK[T]) Min() (T, bool) {
+	return tk.heap.Peek()
+}
+
+// Items returns the kept items, largest first. O(k log k)
+func (tk *TopK[T]) Items() []T {
+	items := make([]T, len(tk.heap.items))
+	copy(items, tk.heap.items)
+	sort.Slice(items, func(i, j int) bool { return tk.heap.less(items[j], items[i]) })
+	return items
+}
+
+// K returns the number of items TopK keeps once full.
+func (tk *TopK[T]) K() int {
+	return tk.k
+}
+
+// Size returns the number of items currently kept. O(1)
+func (tk *TopK[T]) Size() int {
+	return tk.heap.Size()
+}
+
+// Empty returns whether nothing is kept. O(1)
+func (tk *TopK[T]) Empty() bool {
+	return tk.heap.Empty()
+}
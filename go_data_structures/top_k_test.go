@@ -0,0 +1,39 @@
+package go_data_structures
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// largestFirst returns a sorted copy of s, largest first, truncated to k.
+func largestFirst(s []int, k int) []int {
+	sorted := append([]int(nil), s...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+	return sorted[:min(max(k, 0), len(sorted))]
+}
+
+func TestTopKMatchesSort(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+	r := rand.New(rand.NewSource(6))
+	for it := 0; it < 200; it++ {
+		n, k := r.Intn(300), r.Intn(40)
+		s := make([]int, n)
+		for i := range s {
+			s[i] = r.Intn(100) // small range, so ties are common
+		}
+		want := largestFirst(s, k)
+
+		tk := NewTopK(k, less)
+		for _, v := range s {
+			tk.Add(v)
+		}
+		if got := tk.Items(); len(got) != len(want) || (len(want) > 0 && !reflect.DeepEqual(got, want)) {
+			t.Fatalf("TopK(%d).Items() = %v, want %v", k, got, want)
+		}
+		if m, ok := tk.Min(); ok != (len(want) > 0) || (ok && m != want[len(want)-1]) {
+			t.Fatalf("TopK(%d).Min() = %d, %v", k, m, ok)
+		}
+	}
+}
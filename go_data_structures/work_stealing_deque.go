@@ -0,0 +1,124 @@
+package go_data_structures
+
+import (
+	"sync/atomic"
+)
+
+// chaseLevArray is one generation of a WorkStealingDeque's circular buffer.
+// Slots hold pointers so thieves can read them atomically while the owner
+// writes elsewhere in the buffer.
+type chaseLevArray[T any] struct {
+	slots []atomic.Pointer[T]
+	mask  int64
+}
+
+func newChaseLevArray[T any](capacity int) *chaseLevArray[T] {
+	return &chaseLevArray[T]{slots: make([]atomic.Pointer[T], capacity), mask: int64(capacity - 1)}
+}
+
+func (a *chaseLevArray[T]) get(i int64) *T {
+	return a.slots[i&a.mask].Load()
+}
+
+func (a *chaseLevArray[T]) put(i int64, val *T) {
+	a.slots[i&a.mask].Store(val)
+}
+
+// grow returns a buffer twice the size holding the items from top to bottom-1.
+func (a *chaseLevArray[T]) grow(top, bottom int64) *chaseLevArray[T] {
+	grown := newChaseLevArray[T](2 * len(a.slots))
+	for i := top; i < bottom; i++ {
+		grown.put(i, a.get(i))
+	}
+	return grown
+}
+
+// WorkStealingDeque is a Chase-Lev deque: one owner goroutine pushes and
+// pops at the bottom, LIFO, while any number of thieves steal from the top,
+// FIFO. The owner only contends with thieves over the last item, so it
+// works mostly without synchronization. The buffer doubles when full.
+type WorkStealingDeque[T any] struct {
+	_      [cacheLineSize]byte
+	top    atomic.Int64 // next index to steal; only ever increases
+	_      [cacheLineSize - 8]byte
+	bottom atomic.Int64 // next index to push; written only by the owner
+	_      [cacheLineSize - 8]byte
+	array  atomic.Pointer[chaseLevArray[T]]
+}
+
+// NewWorkStealingDeque creates an empty deque with room for capacity items,
+// rounded up to a power of two, before it grows.
+func NewWorkStealingDeque[T any](capacity int) *WorkStealingDeque[T] {
+	d := &WorkStealingDeque[T]{}
+	d.array.Store(newChaseLevArray[T](nextPowerOfTwo(max(capacity, 2))))
+	return d
+}
+
+// Push adds val at the bottom. Only the owner may call it. Amortized O(1)
+func (d *WorkStealingDeque[T]) Push(val T) {
+	b, t := d.bottom.Load(), d.top.Load()
+	a := d.array.Load()
+	if b-t > a.mask {
+		a = a.grow(t, b)
+		d.array.Store(a)
+	}
+	a.put(b, &val)
+	d.bottom.Store(b + 1)
+}
+
+// Pop removes and returns the bottom item, the one pushed last, and false
+// if the deque is empty. Only the owner may call it. O(1)
+func (d *WorkStealingDeque[T]) Pop() (T, bool) {
+	var zero T
+	b := d.bottom.Load() - 1
+	a := d.array.Load()
+	// Claim the bottom slot before looking at top, so a thief either sees
+	// the smaller bottom or has already advanced top.
+	d.bottom.Store(b)
+	t := d.top.Load()
+	if t > b {
+		d.bottom.Store(b + 1)
+		return zero, false
+	}
+	p := a.get(b)
+	if t == b {
+		// The last item: race any thieves for it.
+		if !d.top.CompareAndSwap(t, t+1) {
+			p = nil
+		}
+		d.bottom.Store(b + 1)
+	}
+	if p == nil {
+		return zero, false
+	}
+	a.put(b, nil) // the slot holds the item until the owner pushes there again
+	return *p, true
+}
+
+// Steal removes and returns the top item, the oldest, and false if the deque
+// is empty or another thief or the owner took that item first. Any goroutine
+// may call it. O(1)
+func (d *WorkStealingDeque[T]) Steal() (T, bool) {
+	var zero T
+	t := d.top.Load()
+	b := d.bottom.Load()
+	if t >= b {
+		return zero, false
+	}
+	p := d.array.Load().get(t)
+	if p == nil || !d.top.CompareAndSwap(t, t+1) {
+		return zero, false
+	}
+	return *p, true
+}
+
+// Empty returns whether the deque is empty. Under concurrent use the answer may be stale.
+func (d *WorkStealingDeque[T]) Empty() bool {
+	return d.Size() == 0
+}
+
+// Size returns the number of items. Under concurrent use the answer may be stale.
+func (d *WorkStealingDeque[T]) Size() int {
+	b, t := d.bottom.Load(), d.top.Load()
+	return int(max(b-t, 0))
+}
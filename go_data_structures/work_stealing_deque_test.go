@@ -0,0 +1,108 @@
+package go_data_structures
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// stealUntil runs thieves goroutines stealing from d until stop is set,
+// counting every item they get in seen.
+func stealUntil(d *WorkStealingDeque[int], thieves int, seen []atomic.Int32, stop *atomic.Bool) *sync.WaitGroup {
+	var wg sync.WaitGroup
+	for i := 0; i < thieves; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for !stop.Load() {
+				if v, ok := d.Steal(); ok {
+					seen[v].Add(1)
+				} else {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+	return &wg
+}
+
+func checkSeenOnce(t *testing.T, seen []atomic.Int32) {
+	t.Helper()
+	for v := range seen {
+		if c := seen[v].Load(); c != 1 {
+			t.Fatalf("item %d seen %d times", v, c)
+		}
+	}
+}
+
+func TestWorkStealingDequeOwnerAndThieves(t *testing.T) {
+	const n = 20000
+	d := NewWorkStealingDeque[int](2) // small, so Push grows it while thieves read
+	seen := make([]atomic.Int32, n)
+	var stop atomic.Bool
+	wg := stealUntil(d, 3, seen, &stop)
+	for i := 0; i < n; i++ {
+		d.Push(i)
+		if i%3 == 0 {
+			if v, ok := d.Pop(); ok {
+				seen[v].Add(1)
+			}
+		}
+	}
+	for !d.Empty() {
+		if v, ok := d.Pop(); ok {
+			seen[v].Add(1)
+		}
+	}
+	stop.Store(true)
+	wg.Wait()
+	checkSeenOnce(t, seen)
+}
+
+func TestWorkStealingDequeLastItemRace(t *testing.T) {
+	// The owner pushes one item and pops it straight back, so every Pop
+	// races the thieves for the deque's only item.
+	const n = 20000
+	d := NewWorkStealingDeque[int](4)
+	seen := make([]atomic.Int32, n)
+	var stop atomic.Bool
+	wg := stealUntil(d, 3, seen, &stop)
+	popped := 0
+	for i := 0; i < n; i++ {
+		d.Push(i)
+		if i%2 == 0 {
+			runtime.Gosched() // give the thieves a turn even on one CPU
+		}
+		if v, ok := d.Pop(); ok {
+			if v != i {
+				t.Fatalf("Pop() = %d, want %d", v, i)
+			}
+			seen[v].Add(1)
+			popped++
+		}
+		if !d.Empty() {
+			t.Fatalf("deque not empty after Pop")
+		}
+	}
+	stop.Store(true)
+	wg.Wait()
+	checkSeenOnce(t, seen)
+	t.Logf("owner popped %d of %d, thieves stole the rest", popped, n)
+}
+
+func TestWorkStealingDequeOrder(t *testing.T) {
+	d := NewWorkStealingDeque[int](2)
+	for i := 0; i < 10; i++ {
+		d.Push(i)
+	}
+	if v, ok := d.Steal(); !ok || v != 0 {
+		t.Fatalf("Steal() = %d, %v, want the oldest item 0", v, ok)
+	}
+	if v, ok := d.Pop(); !ok || v != 9 {
+		t.Fatalf("Pop() = %d, %v, want the newest item 9", v, ok)
+	}
+	if d.Size() != 8 {
+		t.Fatalf("Size() = %d, want 8", d.Size())
+	}
+}
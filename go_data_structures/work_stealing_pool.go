@@ -0,0 +1,105 @@
+package go_data_structures
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Task is a unit of work run by a WorkStealingPool. It can fork more work
+// through the worker running it.
+type Task func(w *PoolWorker)
+
+// PoolWorker is one goroutine of a WorkStealingPool, with its own deque.
+type PoolWorker struct {
+	id    int
+	deque *WorkStealingDeque[Task]
+	pool  *WorkStealingPool
+	rng   uint64 // picks steal victims
+}
+
+// ID returns the worker's index in the pool.
+func (w *PoolWorker) ID() int {
+	return w.id
+}
+
+// Spawn queues task on this worker's deque, where the worker itself will
+// run it next unless another worker steals it first.
+func (w *PoolWorker) Spawn(task Task) {
+	w.pool.pending.Add(1)
+	w.deque.Push(task)
+}
+
+// WorkStealingPool is a small fork/join scheduler showing WorkStealingDeque
+// in use. Each worker runs tasks from the bottom of its own deque, newest
+// first, and when that is empty steals the oldest task from a random other
+// worker, so large early tasks spread while each worker stays cache-warm.
+type WorkStealingPool struct {
+	workers []*PoolWorker
+	pending atomic.Int64 // tasks spawned but not yet finished
+}
+
+// NewWorkStealingPool creates a pool of n workers, or GOMAXPROCS workers if n is not positive.
+func NewWorkStealingPool(n int) *WorkStealingPool {
+	if n <= 0 {
+		n = runtime.GOMAXPROCS(0)
+	}
+	pool := &WorkStealingPool{workers: make([]*PoolWorker, n)}
+	for i := range pool.workers {
+		pool.workers[i] = &PoolWorker{id: i, deque: NewWorkStealingDeque[Task](64), pool: pool, rng: uint64(i)*0x9e3779b97f4a7c15 + 1}
+	}
+	return pool
+}
+
+// Run runs root and every task it spawns, directly or indirectly, and
+// returns when all of them have finished. Calls to Run must not overlap.
+func (pool *WorkStealingPool) Run(root Task) {
+	pool.pending.Store(1)
+	pool.workers[0].deque.Push(root) // safe: no worker is running yet
+	var wg sync.WaitGroup
+	for _, w := range pool.workers {
+		wg.Add(1)
+		go func(w *PoolWorker) {
+			defer wg.Done()
+			w.run()
+		}(w)
+	}
+	wg.Wait()
+}
+
+// Size returns the number of workers.
+func (pool *WorkStealingPool) Size() int {
+	return len(pool.workers)
+}
+
+func (w *PoolWorker) run() {
+	for w.pool.pending.Load() > 0 {
+		task, ok := w.deque.Pop()
+		if !ok {
+			task, ok = w.steal()
+		}
+		if !ok {
+			runtime.Gosched()
+			continue
+		}
+		task(w)
+		w.pool.pending.Add(-1)
+	}
+}
+
+// steal tries each other worker once, starting from a random one.
+func (w *PoolWorker) steal() (Task, bool) {
+	workers := w.pool.workers
+	w.rng = mix64(w.rng + 0x9e3779b97f4a7c15)
+	start := int(w.rng % uint64(len(workers)))
+	for i := range workers {
+		victim := workers[(start+i)%len(workers)]
+		if victim == w {
+			continue
+		}
+		if task, ok := victim.deque.Steal(); ok {
+			return task, true
+		}
+	}
+	return nil, false
+}
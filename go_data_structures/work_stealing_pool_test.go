@@ -0,0 +1,55 @@
+package go_data_structures
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+// poolFib adds fib(n) to out, forking one branch as a new task.
+func poolFib(w *PoolWorker, n int, out *atomic.Int64) {
+	if n < 2 {
+		out.Add(int64(n))
+		return
+	}
+	w.Spawn(func(w *PoolWorker) { poolFib(w, n-1, out) })
+	poolFib(w, n-2, out)
+}
+
+// poolSum adds lo+...+hi-1 to out, splitting the range in half until it is small.
+func poolSum(w *PoolWorker, lo, hi int, out *atomic.Int64) {
+	if hi-lo <= 16 {
+		sum := 0
+		for i := lo; i < hi; i++ {
+			sum += i
+		}
+		out.Add(int64(sum))
+		return
+	}
+	mid := (lo + hi) / 2
+	w.Spawn(func(w *PoolWorker) { poolSum(w, lo, mid, out) })
+	w.Spawn(func(w *PoolWorker) { poolSum(w, mid, hi, out) })
+}
+
+func TestWorkStealingPoolFib(t *testing.T) {
+	pool := NewWorkStealingPool(4)
+	var out atomic.Int64
+	pool.Run(func(w *PoolWorker) { poolFib(w, 18, &out) })
+	if out.Load() != 2584 {
+		t.Fatalf("fib(18) = %d, want 2584", out.Load())
+	}
+}
+
+func TestWorkStealingPoolSum(t *testing.T) {
+	pool := NewWorkStealingPool(3)
+	// Run returns only once every task has finished, so the pool can be reused.
+	for round := 0; round < 3; round++ {
+		var out atomic.Int64
+		pool.Run(func(w *PoolWorker) { poolSum(w, 0, 100000, &out) })
+		if out.Load() != 100000*99999/2 {
+			t.Fatalf("round %d: sum = %d, want %d", round, out.Load(), 100000*99999/2)
+		}
+	}
+	if pool.Size() != 3 {
+		t.Fatalf("Size() = %d, want 3", pool.Size())
+	}
+}
@@ -2,13 +2,13 @@ package main
 
 import (
 	"fmt"
-	"/Users/cephus/Desktop/DataStructuresBoost/go_data_structures"
 
+	"go_data_structures/go_data_structures"
 )
 
 func main() {
 	// Create a new BinarySearchTree instance.
-    bst := NewBinarySearchTree[string, int]()
+    bst := go_data_structures.NewBinarySearchTree[string, int]()
 	fmt.Println(bst)
 	// Test Insert.
 	bst.Insert("apple", 5)